@@ -0,0 +1,152 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/go-tfe"
+	log "github.com/sirupsen/logrus"
+)
+
+// integrationHeader identifies this server to TFC/TFE, matching the header the upstream cloud
+// backend sends so request logs and rate-limit dashboards can distinguish MCP-driven traffic.
+const integrationHeader = "X-Terraform-Integration"
+
+const defaultRetryMax = 5
+const defaultRetryWaitMin = 1 * time.Second
+const defaultRetryWaitMax = 30 * time.Second
+
+// ClientOverrides lets a single tool call target a different TFC/TFE organization or identity
+// than the server's default TFE_TOKEN/TFE_ADDRESS, so one MCP server process can broker multiple
+// orgs and users without restarting.
+type ClientOverrides struct {
+	Hostname      string
+	Token         string
+	SkipTLSVerify bool
+	RetryMax      int
+	RetryWaitMin  time.Duration
+	RetryWaitMax  time.Duration
+}
+
+var (
+	clientCacheMu sync.Mutex
+	clientCache   = map[string]*tfe.Client{}
+)
+
+// GetTfeClientFromContext returns a tfe.Client built from the TFE_TOKEN/TFE_ADDRESS environment
+// variables. This is the single-identity path used by tools that don't accept per-call
+// credential overrides.
+func GetTfeClientFromContext(ctx context.Context, logger *log.Logger) (*tfe.Client, error) {
+	return GetTfeClient(ctx, logger, nil)
+}
+
+// GetTfeClient returns a tfe.Client for the given overrides, falling back to the
+// TFE_TOKEN/TFE_ADDRESS environment variables for any field left unset. Clients are cached by
+// (hostname, token-hash, TLS and retry settings) so repeated calls for the same identity reuse the same underlying
+// connection pool and retry state.
+func GetTfeClient(ctx context.Context, logger *log.Logger, overrides *ClientOverrides) (*tfe.Client, error) {
+	hostname := os.Getenv("TFE_ADDRESS")
+	token := os.Getenv("TFE_TOKEN")
+	skipTLSVerify := false
+	retryMax := defaultRetryMax
+	retryWaitMin := defaultRetryWaitMin
+	retryWaitMax := defaultRetryWaitMax
+
+	if overrides != nil {
+		if overrides.Hostname != "" {
+			hostname = overrides.Hostname
+		}
+		if overrides.Token != "" {
+			token = overrides.Token
+		}
+		skipTLSVerify = overrides.SkipTLSVerify
+		if overrides.RetryMax > 0 {
+			retryMax = overrides.RetryMax
+		}
+		if overrides.RetryWaitMin > 0 {
+			retryWaitMin = overrides.RetryWaitMin
+		}
+		if overrides.RetryWaitMax > 0 {
+			retryWaitMax = overrides.RetryWaitMax
+		}
+	}
+
+	if hostname == "" {
+		return nil, fmt.Errorf("no TFE hostname configured - set TFE_ADDRESS or pass tfe_hostname")
+	}
+	if token == "" {
+		return nil, fmt.Errorf("no TFE token configured - set TFE_TOKEN or pass tfe_token")
+	}
+
+	cacheKey := cacheKeyFor(hostname, token, skipTLSVerify, retryMax, retryWaitMin, retryWaitMax)
+
+	clientCacheMu.Lock()
+	defer clientCacheMu.Unlock()
+
+	if cached, ok := clientCache[cacheKey]; ok {
+		return cached, nil
+	}
+
+	retryClient := retryablehttp.NewClient()
+	retryClient.RetryMax = retryMax
+	retryClient.RetryWaitMin = retryWaitMin
+	retryClient.RetryWaitMax = retryWaitMax
+	retryClient.Logger = nil
+	if skipTLSVerify {
+		retryClient.HTTPClient.Transport = &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, // #nosec G402 -- explicit opt-in via tfe_skip_tls_verify
+		}
+	}
+
+	headers := make(http.Header)
+	headers.Set(integrationHeader, "terraform-mcp-server")
+	headers.Set("User-Agent", "terraform-mcp-server")
+
+	tfeConfig := &tfe.Config{
+		Address:           hostname,
+		Token:             token,
+		HTTPClient:        retryClient.StandardClient(),
+		RetryServerErrors: true,
+		Headers:           headers,
+	}
+
+	tfeClient, err := tfe.NewClient(tfeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct TFE client for %s: %w", hostname, err)
+	}
+
+	logger.WithField("hostname", hostname).Debug("constructed new TFE client")
+
+	clientCache[cacheKey] = tfeClient
+	return tfeClient, nil
+}
+
+// WriteEnabled reports whether the server was started with write access to TFC/TFE enabled,
+// via the TFE_MCP_ENABLE_WRITE environment variable (the equivalent of a --enable-write flag).
+// It is off by default. override_policy_check checks it before overriding a policy check; the
+// other tools, including the run tools flagged destructive, do not check it.
+func WriteEnabled() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv("TFE_MCP_ENABLE_WRITE"))
+	return enabled
+}
+
+// cacheKeyFor builds a cache key that doesn't hold the raw token in memory any longer than
+// necessary. It folds in every override that affects the constructed client (TLS verification
+// and retry/backoff settings) so that two calls for the same hostname/token with different
+// overrides don't collide on the same cached client.
+func cacheKeyFor(hostname, token string, skipTLSVerify bool, retryMax int, retryWaitMin, retryWaitMax time.Duration) string {
+	sum := sha256.Sum256([]byte(token))
+	return fmt.Sprintf("%s|%s|%t|%d|%s|%s", hostname, hex.EncodeToString(sum[:]), skipTLSVerify, retryMax, retryWaitMin, retryWaitMax)
+}
@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// ApplyRun creates a tool to confirm and apply a planned Terraform run.
+func ApplyRun(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("apply_run",
+			mcp.WithDescription(`Confirms and applies a Terraform run that is awaiting confirmation (i.e. a plan that finished without auto_apply). This is a destructive operation that will create, modify, or destroy real infrastructure resources.`),
+			mcp.WithTitleAnnotation("Apply a planned Terraform run"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(true),
+			mcp.WithString("run_id",
+				mcp.Required(),
+				mcp.Description("The ID of the run to apply"),
+			),
+			mcp.WithString("comment",
+				mcp.Description("Optional comment to attach to the apply confirmation"),
+			),
+		),
+		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return applyRunHandler(ctx, req, logger)
+		},
+	}
+}
+
+func applyRunHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	runID, err := request.RequireString("run_id")
+	if err != nil {
+		return ToolError(logger, "missing required input: run_id", err)
+	}
+	comment := request.GetString("comment", "")
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	run, err := tfeClient.Runs.Read(ctx, runID)
+	if err != nil {
+		return ToolErrorf(logger, "run not found: %s", runID)
+	}
+	if !run.Actions.IsConfirmable {
+		return ToolErrorf(logger, "run %s is not confirmable in its current status '%s'", runID, run.Status)
+	}
+
+	options := tfe.RunApplyOptions{}
+	if comment != "" {
+		options.Comment = &comment
+	}
+
+	if err := tfeClient.Runs.Apply(ctx, runID, options); err != nil {
+		return ToolErrorf(logger, "failed to apply run %s: %v", runID, err)
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Run %s has been confirmed and queued for apply.", runID)), nil
+}
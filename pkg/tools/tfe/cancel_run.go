@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// CancelRun creates a tool to cancel a Terraform run that is currently planning or applying.
+func CancelRun(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("cancel_run",
+			mcp.WithDescription(`Cancels a Terraform run that is in progress (planning, applying, or similar). This sends a graceful cancellation request; use force only if the run isn't responding.`),
+			mcp.WithTitleAnnotation("Cancel an in-progress Terraform run"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(true),
+			mcp.WithString("run_id",
+				mcp.Required(),
+				mcp.Description("The ID of the run to cancel"),
+			),
+			mcp.WithString("comment",
+				mcp.Description("Optional comment explaining why the run was canceled"),
+			),
+			mcp.WithString("force",
+				mcp.Description("Whether to force-cancel a run that isn't responding to a graceful cancel: 'true' or 'false' (default: 'false')"),
+			),
+		),
+		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return cancelRunHandler(ctx, req, logger)
+		},
+	}
+}
+
+func cancelRunHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	runID, err := request.RequireString("run_id")
+	if err != nil {
+		return ToolError(logger, "missing required input: run_id", err)
+	}
+	comment := request.GetString("comment", "")
+	force := request.GetString("force", "false") == "true"
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	run, err := tfeClient.Runs.Read(ctx, runID)
+	if err != nil {
+		return ToolErrorf(logger, "run not found: %s", runID)
+	}
+
+	if force {
+		if !run.Actions.IsForceCancelable {
+			return ToolErrorf(logger, "run %s is not force-cancelable in its current status '%s'", runID, run.Status)
+		}
+		options := tfe.RunForceCancelOptions{}
+		if comment != "" {
+			options.Comment = &comment
+		}
+		if err := tfeClient.Runs.ForceCancel(ctx, runID, options); err != nil {
+			return ToolErrorf(logger, "failed to force-cancel run %s: %v", runID, err)
+		}
+		return mcp.NewToolResultText(fmt.Sprintf("Run %s has been force-canceled.", runID)), nil
+	}
+
+	if !run.Actions.IsCancelable {
+		return ToolErrorf(logger, "run %s is not cancelable in its current status '%s'", runID, run.Status)
+	}
+
+	options := tfe.RunCancelOptions{}
+	if comment != "" {
+		options.Comment = &comment
+	}
+	if err := tfeClient.Runs.Cancel(ctx, runID, options); err != nil {
+		return ToolErrorf(logger, "failed to cancel run %s: %v", runID, err)
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Run %s has been canceled.", runID)), nil
+}
@@ -0,0 +1,226 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// runStatusWaitTargets maps the wait_for parameter to the tfe.RunStatus values that satisfy it.
+var runStatusWaitTargets = map[string][]tfe.RunStatus{
+	"planned":        {tfe.RunPlanned, tfe.RunPlannedAndFinished, tfe.RunCostEstimated, tfe.RunPolicyChecked, tfe.RunApplying, tfe.RunApplied, tfe.RunErrored, tfe.RunDiscarded, tfe.RunCanceled},
+	"cost_estimated": {tfe.RunCostEstimated, tfe.RunPolicyChecked, tfe.RunApplying, tfe.RunApplied, tfe.RunPlannedAndFinished, tfe.RunErrored, tfe.RunDiscarded, tfe.RunCanceled},
+	"applied":        {tfe.RunApplied, tfe.RunErrored, tfe.RunDiscarded, tfe.RunCanceled},
+}
+
+// CreateRun creates a tool to queue a new Terraform run (plan, and optionally apply) on a workspace.
+func CreateRun(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("create_run",
+			mcp.WithDescription(`Queues a new Terraform run on a workspace, equivalent to the CLI-driven remote run workflow. Optionally waits (with backoff, up to a configurable deadline) for the run to reach a target status such as "planned" or "applied" before returning, including a tail of the plan/apply log. This is a destructive operation when auto_apply is enabled or the run is later applied.`),
+			mcp.WithTitleAnnotation("Queue a new Terraform run"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(true),
+			mcp.WithString("workspace_id",
+				mcp.Required(),
+				mcp.Description("The ID of the workspace to run Terraform on"),
+			),
+			mcp.WithString("message",
+				mcp.Description("Optional message describing the run"),
+			),
+			mcp.WithString("is_destroy",
+				mcp.Description("Whether this is a destroy run: 'true' or 'false' (default: 'false')"),
+			),
+			mcp.WithString("auto_apply",
+				mcp.Description("Whether to automatically apply the run if the plan succeeds: 'true' or 'false' (default: workspace setting)"),
+			),
+			mcp.WithString("target_addrs",
+				mcp.Description("Optional comma-separated list of resource addresses to target"),
+			),
+			mcp.WithString("replace_addrs",
+				mcp.Description("Optional comma-separated list of resource addresses to force replacement of"),
+			),
+			mcp.WithString("refresh_only",
+				mcp.Description("Whether this run should only refresh state without proposing changes: 'true' or 'false' (default: 'false')"),
+			),
+			mcp.WithString("wait_for",
+				mcp.Description("Optional status to poll for before returning: 'planned', 'cost_estimated', or 'applied'. If omitted, returns immediately after queuing."),
+			),
+			mcp.WithString("wait_timeout_minutes",
+				mcp.Description("Maximum minutes to poll when wait_for is set (default: 30)"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return createRunHandler(ctx, request, logger)
+		},
+	}
+}
+
+func createRunHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	workspaceID, err := request.RequireString("workspace_id")
+	if err != nil {
+		return ToolError(logger, "missing required input: workspace_id", err)
+	}
+	workspaceID = strings.TrimSpace(workspaceID)
+
+	message := request.GetString("message", "")
+	isDestroy := strings.ToLower(request.GetString("is_destroy", "false")) == "true"
+	refreshOnly := strings.ToLower(request.GetString("refresh_only", "false")) == "true"
+	targetAddrs := splitAndTrim(request.GetString("target_addrs", ""))
+	replaceAddrs := splitAndTrim(request.GetString("replace_addrs", ""))
+	waitFor := strings.ToLower(strings.TrimSpace(request.GetString("wait_for", "")))
+
+	waitTimeoutMinutes := 30
+	if v := request.GetString("wait_timeout_minutes", ""); v != "" {
+		if _, err := fmt.Sscanf(v, "%d", &waitTimeoutMinutes); err != nil || waitTimeoutMinutes <= 0 {
+			return ToolErrorf(logger, "invalid wait_timeout_minutes '%s' - must be a positive integer", v)
+		}
+	}
+
+	options := tfe.RunCreateOptions{
+		Workspace:   &tfe.Workspace{ID: workspaceID},
+		IsDestroy:   tfe.Bool(isDestroy),
+		RefreshOnly: tfe.Bool(refreshOnly),
+	}
+	if message != "" {
+		options.Message = &message
+	}
+	if len(targetAddrs) > 0 {
+		options.TargetAddrs = targetAddrs
+	}
+	if len(replaceAddrs) > 0 {
+		options.ReplaceAddrs = replaceAddrs
+	}
+	if v := request.GetString("auto_apply", ""); v != "" {
+		autoApply := strings.ToLower(v) == "true"
+		options.AutoApply = &autoApply
+	}
+
+	if waitFor != "" {
+		if _, ok := runStatusWaitTargets[waitFor]; !ok {
+			return ToolErrorf(logger, "invalid wait_for '%s' - must be 'planned', 'cost_estimated', or 'applied'", waitFor)
+		}
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	run, err := tfeClient.Runs.Create(ctx, options)
+	if err != nil {
+		return ToolErrorf(logger, "failed to create run on workspace '%s': %v", workspaceID, err)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Created Run %s\n\n", run.ID))
+	sb.WriteString(fmt.Sprintf("**Workspace ID:** %s\n", workspaceID))
+	sb.WriteString(fmt.Sprintf("**Status:** %s\n", run.Status))
+
+	if waitFor == "" {
+		return mcp.NewToolResultText(sb.String()), nil
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, time.Duration(waitTimeoutMinutes)*time.Minute)
+	defer cancel()
+
+	finalRun, err := pollRunStatus(waitCtx, tfeClient, run.ID, runStatusWaitTargets[waitFor])
+	if err != nil {
+		if waitCtx.Err() == context.DeadlineExceeded {
+			sb.WriteString(fmt.Sprintf("\n> **Note:** Timed out after %d minutes waiting for status '%s'. The run may still be in progress - use get_run_status to check again.\n", waitTimeoutMinutes, waitFor))
+			return mcp.NewToolResultText(sb.String()), nil
+		}
+		return ToolErrorf(logger, "error while waiting for run %s to reach '%s': %v", run.ID, waitFor, err)
+	}
+
+	sb.WriteString(fmt.Sprintf("**Final Status:** %s\n", finalRun.Status))
+	sb.WriteString(logTailForRun(ctx, tfeClient, finalRun, logger))
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+// pollRunStatus polls a run's status with exponential backoff until it matches one of the
+// target statuses or the context is canceled/times out.
+func pollRunStatus(ctx context.Context, tfeClient *tfe.Client, runID string, targets []tfe.RunStatus) (*tfe.Run, error) {
+	wait := 2 * time.Second
+	const maxWait = 30 * time.Second
+
+	for {
+		run, err := tfeClient.Runs.Read(ctx, runID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, target := range targets {
+			if run.Status == target {
+				return run, nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+
+		wait *= 2
+		if wait > maxWait {
+			wait = maxWait
+		}
+	}
+}
+
+// logTailForRun returns a Markdown section containing the tail of the plan log (or apply log,
+// once the run has an apply) for the given run, for inclusion in a wait_for response.
+func logTailForRun(ctx context.Context, tfeClient *tfe.Client, run *tfe.Run, logger *log.Logger) string {
+	const maxLogSize = 10000
+
+	var logReader io.Reader
+	var err error
+	section := "Plan Log"
+
+	if run.Apply != nil {
+		section = "Apply Log"
+		logReader, err = tfeClient.Applies.Logs(ctx, run.Apply.ID)
+	} else if run.Plan != nil {
+		logReader, err = tfeClient.Plans.Logs(ctx, run.Plan.ID)
+	} else {
+		return ""
+	}
+
+	if err != nil {
+		logger.WithError(err).Warn("Could not fetch run log")
+		return ""
+	}
+
+	logBytes, err := io.ReadAll(logReader)
+	if err != nil {
+		logger.WithError(err).Warn("Could not read run log")
+		return ""
+	}
+
+	logContent := string(logBytes)
+	if logContent == "" {
+		return ""
+	}
+	if len(logContent) > maxLogSize {
+		logContent = logContent[len(logContent)-maxLogSize:]
+	}
+	if !strings.HasSuffix(logContent, "\n") {
+		logContent += "\n"
+	}
+
+	return fmt.Sprintf("\n## %s (tail)\n\n```\n%s```\n", section, logContent)
+}
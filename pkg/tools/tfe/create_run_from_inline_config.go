@@ -0,0 +1,241 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// CreateRunFromInlineConfig creates a tool that uploads an ad-hoc configuration version to an
+// existing workspace and starts a run from it, with no pre-existing VCS connection required.
+// Complements the read-only GetCurrentState/GetApplyDetails surface by letting agents author
+// small experimental modules end-to-end (create CV -> run -> poll -> apply).
+func CreateRunFromInlineConfig(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("create_run_from_inline_config",
+			mcp.WithDescription(`Creates a configuration version on an existing workspace from either inline HCL or a registry module reference, and starts a run from it. Exactly one source must be given: inline_files (a map of filename to HCL content, for ad-hoc modules) or from_module (a registry module address, e.g. "terraform-aws-modules/vpc/aws", wrapped in a generated root module). This is a destructive operation when the run is later applied - use speculative='true' for a plan-only configuration version that can never be applied.`),
+			mcp.WithTitleAnnotation("Create a Terraform run from inline configuration"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(true),
+			mcp.WithString("workspace_id",
+				mcp.Required(),
+				mcp.Description("The ID of the workspace to upload the configuration version to and run on"),
+			),
+			mcp.WithString("inline_files",
+				mcp.Description(`A JSON object mapping filename to HCL contents (e.g. '{"main.tf": "..."}'). Mutually exclusive with from_module.`),
+			),
+			mcp.WithString("from_module",
+				mcp.Description(`A registry module source address (e.g. "terraform-aws-modules/vpc/aws"), wrapped in a generated root module that calls it. Mutually exclusive with inline_files.`),
+			),
+			mcp.WithString("module_variables",
+				mcp.Description(`Optional JSON object of input variables to pass to the module when using from_module (e.g. '{"name": "example"}')`),
+			),
+			mcp.WithString("speculative",
+				mcp.Description("Whether the configuration version is plan-only and can never be applied: 'true' or 'false' (default: 'false')"),
+			),
+			mcp.WithString("variables",
+				mcp.Description(`Optional JSON object of run-scoped Terraform variables (e.g. '{"instance_count": "3"}'), overriding the workspace's own variables for this run only`),
+			),
+			mcp.WithString("target_addrs",
+				mcp.Description("Optional comma-separated list of resource addresses to target"),
+			),
+			mcp.WithString("replace_addrs",
+				mcp.Description("Optional comma-separated list of resource addresses to force replacement of"),
+			),
+			mcp.WithString("message",
+				mcp.Description("Optional message describing the run"),
+			),
+			mcp.WithString("wait_for",
+				mcp.Description("Optional status to poll for before returning: 'planned', 'cost_estimated', or 'applied'. If omitted, returns immediately after queuing."),
+			),
+			mcp.WithString("wait_timeout_minutes",
+				mcp.Description("Maximum minutes to poll when wait_for is set (default: 30)"),
+			),
+		),
+		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return createRunFromInlineConfigHandler(ctx, req, logger)
+		},
+	}
+}
+
+func createRunFromInlineConfigHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	workspaceID, err := request.RequireString("workspace_id")
+	if err != nil {
+		return ToolError(logger, "missing required input: workspace_id", err)
+	}
+	workspaceID = strings.TrimSpace(workspaceID)
+
+	inlineFilesStr := strings.TrimSpace(request.GetString("inline_files", ""))
+	fromModule := strings.TrimSpace(request.GetString("from_module", ""))
+
+	if inlineFilesStr == "" && fromModule == "" {
+		return ToolError(logger, "either inline_files or from_module must be provided", nil)
+	}
+	if inlineFilesStr != "" && fromModule != "" {
+		return ToolError(logger, "inline_files and from_module are mutually exclusive - provide only one", nil)
+	}
+
+	files := map[string]string{}
+	if inlineFilesStr != "" {
+		if err := json.Unmarshal([]byte(inlineFilesStr), &files); err != nil {
+			return ToolErrorf(logger, "inline_files must be a JSON object mapping filename to file contents: %v", err)
+		}
+		if _, ok := files["main.tf"]; !ok {
+			return ToolError(logger, "inline_files must include a main.tf entry", nil)
+		}
+	} else {
+		moduleVariables := map[string]string{}
+		if v := strings.TrimSpace(request.GetString("module_variables", "")); v != "" {
+			if err := json.Unmarshal([]byte(v), &moduleVariables); err != nil {
+				return ToolErrorf(logger, "module_variables must be a JSON object of string key/value pairs: %v", err)
+			}
+		}
+		files["main.tf"] = generateModuleWrapper(fromModule, moduleVariables)
+	}
+
+	speculative := strings.ToLower(request.GetString("speculative", "false")) == "true"
+
+	var runVariables []*tfe.RunVariable
+	if v := strings.TrimSpace(request.GetString("variables", "")); v != "" {
+		rawVariables := map[string]string{}
+		if err := json.Unmarshal([]byte(v), &rawVariables); err != nil {
+			return ToolErrorf(logger, "variables must be a JSON object of string key/value pairs: %v", err)
+		}
+		for key, value := range rawVariables {
+			runVariables = append(runVariables, &tfe.RunVariable{Key: key, Value: value})
+		}
+	}
+
+	targetAddrs := splitAndTrim(request.GetString("target_addrs", ""))
+	replaceAddrs := splitAndTrim(request.GetString("replace_addrs", ""))
+	message := request.GetString("message", "")
+	waitFor := strings.ToLower(strings.TrimSpace(request.GetString("wait_for", "")))
+	if waitFor != "" {
+		if _, ok := runStatusWaitTargets[waitFor]; !ok {
+			return ToolErrorf(logger, "invalid wait_for '%s' - must be 'planned', 'cost_estimated', or 'applied'", waitFor)
+		}
+	}
+
+	waitTimeoutMinutes := 30
+	if v := request.GetString("wait_timeout_minutes", ""); v != "" {
+		if _, err := fmt.Sscanf(v, "%d", &waitTimeoutMinutes); err != nil || waitTimeoutMinutes <= 0 {
+			return ToolErrorf(logger, "invalid wait_timeout_minutes '%s' - must be a positive integer", v)
+		}
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	cv, err := tfeClient.ConfigurationVersions.Create(ctx, workspaceID, tfe.ConfigurationVersionCreateOptions{
+		AutoQueueRuns: tfe.Bool(false),
+		Speculative:   tfe.Bool(speculative),
+	})
+	if err != nil {
+		return ToolErrorf(logger, "failed to create configuration version on workspace '%s': %v", workspaceID, err)
+	}
+
+	slugBytes, err := packInlineModule(files["main.tf"], withoutMainTf(files))
+	if err != nil {
+		return ToolErrorf(logger, "failed to package configuration for workspace '%s': %v", workspaceID, err)
+	}
+
+	if err := tfeClient.ConfigurationVersions.Upload(ctx, cv.UploadURL, bytes.NewReader(slugBytes)); err != nil {
+		return ToolErrorf(logger, "configuration version %s was created but the upload failed: %v", cv.ID, err)
+	}
+
+	options := tfe.RunCreateOptions{
+		Workspace:            &tfe.Workspace{ID: workspaceID},
+		ConfigurationVersion: cv,
+		Variables:            runVariables,
+	}
+	if message != "" {
+		options.Message = &message
+	}
+	if len(targetAddrs) > 0 {
+		options.TargetAddrs = targetAddrs
+	}
+	if len(replaceAddrs) > 0 {
+		options.ReplaceAddrs = replaceAddrs
+	}
+
+	run, err := tfeClient.Runs.Create(ctx, options)
+	if err != nil {
+		return ToolErrorf(logger, "configuration version %s was created but the run could not be started: %v", cv.ID, err)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Created Run %s From Inline Configuration\n\n", run.ID))
+	sb.WriteString(fmt.Sprintf("**Workspace ID:** %s\n", workspaceID))
+	sb.WriteString(fmt.Sprintf("**Configuration Version ID:** %s\n", cv.ID))
+	sb.WriteString(fmt.Sprintf("**Speculative:** %t\n", speculative))
+	sb.WriteString(fmt.Sprintf("**Status:** %s\n", run.Status))
+
+	if waitFor == "" {
+		return mcp.NewToolResultText(sb.String()), nil
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, time.Duration(waitTimeoutMinutes)*time.Minute)
+	defer cancel()
+
+	finalRun, err := pollRunStatus(waitCtx, tfeClient, run.ID, runStatusWaitTargets[waitFor])
+	if err != nil {
+		if waitCtx.Err() == context.DeadlineExceeded {
+			sb.WriteString(fmt.Sprintf("\n> **Note:** Timed out after %d minutes waiting for status '%s'. The run may still be in progress - use get_run_status to check again.\n", waitTimeoutMinutes, waitFor))
+			return mcp.NewToolResultText(sb.String()), nil
+		}
+		return ToolErrorf(logger, "error while waiting for run %s to reach '%s': %v", run.ID, waitFor, err)
+	}
+
+	sb.WriteString(fmt.Sprintf("**Final Status:** %s\n", finalRun.Status))
+	sb.WriteString(logTailForRun(ctx, tfeClient, finalRun, logger))
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+// generateModuleWrapper produces a minimal root module that calls the given registry module
+// address, passing through the given variables as both the module's inputs and root-level
+// variable declarations whose defaults are the given values (so they can also be overridden as
+// run variables).
+func generateModuleWrapper(moduleSource string, variables map[string]string) string {
+	var sb strings.Builder
+	for name, value := range variables {
+		sb.WriteString(fmt.Sprintf("variable %q {\n  type    = string\n  default = %q\n}\n\n", name, value))
+	}
+
+	sb.WriteString("module \"generated\" {\n")
+	sb.WriteString(fmt.Sprintf("  source = %q\n", moduleSource))
+	for name := range variables {
+		sb.WriteString(fmt.Sprintf("  %s = var.%s\n", name, name))
+	}
+	sb.WriteString("}\n")
+
+	return sb.String()
+}
+
+// withoutMainTf returns a copy of files excluding the main.tf entry, for use as the "extra
+// files" argument to packInlineModule, which always writes main.tf itself.
+func withoutMainTf(files map[string]string) map[string]string {
+	extra := make(map[string]string, len(files))
+	for name, contents := range files {
+		if name == "main.tf" {
+			continue
+		}
+		extra[name] = contents
+	}
+	return extra
+}
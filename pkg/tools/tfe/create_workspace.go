@@ -5,6 +5,8 @@ package tools
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"strings"
 	"time"
 
@@ -65,6 +67,30 @@ func CreateWorkspace(logger *log.Logger) server.ServerTool {
 			mcp.WithString("tags",
 				mcp.Description("Optional comma-separated list of tags to apply to the workspace"),
 			),
+			mcp.WithString("trigger_patterns",
+				mcp.Description("Optional comma-separated list of glob patterns (e.g. 'projects/frontend/**/*') that determine which file changes trigger a run. Requires a VCS repo and is mutually exclusive with trigger_prefixes."),
+			),
+			mcp.WithString("trigger_prefixes",
+				mcp.Description("Optional comma-separated list of path prefixes that determine which file changes trigger a run. Requires a VCS repo and is mutually exclusive with trigger_patterns."),
+			),
+			mcp.WithString("file_triggers_enabled",
+				mcp.Description("Whether runs should only be triggered by changes to files relevant to the working directory, trigger_patterns, or trigger_prefixes: 'true' or 'false' (default: not set, leaving the Terraform Cloud/Enterprise default)"),
+			),
+			mcp.WithString("team_access",
+				mcp.Description(`Optional JSON array of team access grants to apply after the workspace is created, e.g. '[{"team_id": "team-abc123", "access": "write"}]'. The "access" field must be one of 'read', 'plan', 'write', 'admin', or 'custom'; when 'custom', a "permissions" object of the tfe.WorkspaceAccess fields may also be supplied.`),
+			),
+			mcp.WithString("variable_set_ids",
+				mcp.Description("Optional comma-separated list of variable set IDs to apply to the workspace after creation"),
+			),
+			mcp.WithString("tfe_hostname",
+				mcp.Description("Optional TFC/TFE hostname to target for this call instead of the server's default TFE_ADDRESS, for brokering multiple orgs from one server"),
+			),
+			mcp.WithString("tfe_token",
+				mcp.Description("Optional TFC/TFE API token to use for this call instead of the server's default TFE_TOKEN"),
+			),
+			mcp.WithString("tfe_skip_tls_verify",
+				mcp.Description("Whether to skip TLS certificate verification when tfe_hostname points at a TFE instance with a self-signed certificate: 'true' or 'false' (default: 'false')"),
+			),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			return createWorkspaceHandler(ctx, request, logger)
@@ -95,6 +121,19 @@ func createWorkspaceHandler(ctx context.Context, request mcp.CallToolRequest, lo
 	vcsRepoBranch := request.GetString("vcs_repo_branch", "")
 	vcsRepoOAuthTokenID := request.GetString("vcs_repo_oauth_token_id", "")
 	tagsStr := request.GetString("tags", "")
+	triggerPatternsStr := request.GetString("trigger_patterns", "")
+	triggerPrefixesStr := request.GetString("trigger_prefixes", "")
+	fileTriggersEnabledStr := request.GetString("file_triggers_enabled", "")
+	teamAccessStr := request.GetString("team_access", "")
+	variableSetIDsStr := request.GetString("variable_set_ids", "")
+
+	var teamAccessGrants []teamAccessGrant
+	if teamAccessStr != "" {
+		if err := json.Unmarshal([]byte(teamAccessStr), &teamAccessGrants); err != nil {
+			return ToolErrorf(logger, "team_access must be a JSON array of {team_id, access, permissions}: %v", err)
+		}
+	}
+	variableSetIDs := splitAndTrim(variableSetIDsStr)
 
 	autoApply := strings.ToLower(autoApplyStr) == "true"
 
@@ -122,9 +161,9 @@ func createWorkspaceHandler(ctx context.Context, request mcp.CallToolRequest, lo
 		}
 	}
 
-	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	tfeClient, err := client.GetTfeClient(ctx, logger, clientOverridesFromRequest(request))
 	if err != nil {
-		return ToolError(logger, "failed to get Terraform client - ensure TFE_TOKEN and TFE_ADDRESS are configured", err)
+		return ToolError(logger, "failed to get Terraform client - ensure TFE_TOKEN and TFE_ADDRESS are configured, or pass tfe_hostname/tfe_token", err)
 	}
 
 	options := &tfe.WorkspaceCreateOptions{
@@ -175,6 +214,26 @@ func createWorkspaceHandler(ctx context.Context, request mcp.CallToolRequest, lo
 		options.VCSRepo = vcsRepo
 	}
 
+	if triggerPatternsStr != "" || triggerPrefixesStr != "" || fileTriggersEnabledStr != "" {
+		if vcsRepoIdentifier == "" {
+			return ToolError(logger, "trigger_patterns, trigger_prefixes, and file_triggers_enabled require a VCS repo to be configured via vcs_repo_identifier", nil)
+		}
+		if triggerPatternsStr != "" && triggerPrefixesStr != "" {
+			return ToolError(logger, "trigger_patterns and trigger_prefixes are mutually exclusive - Terraform Cloud/Enterprise rejects workspaces that set both", nil)
+		}
+
+		if triggerPatternsStr != "" {
+			options.TriggerPatterns = splitAndTrim(triggerPatternsStr)
+		}
+		if triggerPrefixesStr != "" {
+			options.TriggerPrefixes = splitAndTrim(triggerPrefixesStr)
+		}
+		if fileTriggersEnabledStr != "" {
+			fileTriggersEnabled := strings.ToLower(fileTriggersEnabledStr) == "true"
+			options.FileTriggersEnabled = &fileTriggersEnabled
+		}
+	}
+
 	createCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
@@ -191,5 +250,108 @@ func createWorkspaceHandler(ctx context.Context, request mcp.CallToolRequest, lo
 		return ToolError(logger, "failed to get workspace details", err)
 	}
 
+	if len(teamAccessGrants) > 0 || len(variableSetIDs) > 0 {
+		report := applyWorkspaceAttachments(ctx, tfeClient, workspace, teamAccessGrants, variableSetIDs, logger)
+		buf.WriteString(report)
+	}
+
 	return mcp.NewToolResultText(buf.String()), nil
 }
+
+// teamAccessGrant describes one entry of the team_access parameter.
+type teamAccessGrant struct {
+	TeamID      string                       `json:"team_id"`
+	Access      string                       `json:"access"`
+	Permissions *teamAccessCustomPermissions `json:"permissions,omitempty"`
+}
+
+// teamAccessCustomPermissions mirrors the subset of tfe.TeamAccessAddOptions that only applies
+// when Access is "custom".
+type teamAccessCustomPermissions struct {
+	Runs             *tfe.RunsPermission          `json:"runs,omitempty"`
+	Variables        *tfe.VariablesPermission     `json:"variables,omitempty"`
+	StateVersions    *tfe.StateVersionsPermission `json:"state_versions,omitempty"`
+	SentinelMocks    *tfe.SentinelMocksPermission `json:"sentinel_mocks,omitempty"`
+	WorkspaceLocking *bool                        `json:"workspace_locking,omitempty"`
+	RunTasks         *bool                        `json:"run_tasks,omitempty"`
+}
+
+// applyWorkspaceAttachments grants team access and applies variable sets to a freshly created
+// workspace, bundling the `tfe_workspace_variable_set` and team-access flows into one call.
+// Each attachment is applied independently so a single failure doesn't undo the others; the
+// returned report lists which attachments succeeded and which failed so the caller can retry
+// only what's missing.
+func applyWorkspaceAttachments(ctx context.Context, tfeClient *tfe.Client, workspace *tfe.Workspace, grants []teamAccessGrant, variableSetIDs []string, logger *log.Logger) string {
+	var sb strings.Builder
+	sb.WriteString("\n## Post-Create Attachments\n\n")
+
+	for _, grant := range grants {
+		access := tfe.AccessType(strings.ToLower(grant.Access))
+		options := tfe.TeamAccessAddOptions{
+			Access:    &access,
+			Team:      &tfe.Team{ID: grant.TeamID},
+			Workspace: workspace,
+		}
+		if access == tfe.AccessCustom && grant.Permissions != nil {
+			options.Runs = grant.Permissions.Runs
+			options.Variables = grant.Permissions.Variables
+			options.StateVersions = grant.Permissions.StateVersions
+			options.SentinelMocks = grant.Permissions.SentinelMocks
+			options.WorkspaceLocking = grant.Permissions.WorkspaceLocking
+			options.RunTasks = grant.Permissions.RunTasks
+		}
+
+		if _, err := tfeClient.TeamAccess.Add(ctx, options); err != nil {
+			logger.WithError(err).Warnf("failed to add team access for team %s", grant.TeamID)
+			sb.WriteString(fmt.Sprintf("- ❌ Team access for `%s` (%s): failed - %v\n", grant.TeamID, grant.Access, err))
+		} else {
+			sb.WriteString(fmt.Sprintf("- ✅ Team access for `%s` (%s): applied\n", grant.TeamID, grant.Access))
+		}
+	}
+
+	for _, variableSetID := range variableSetIDs {
+		err := tfeClient.VariableSets.ApplyToWorkspaces(ctx, variableSetID, &tfe.VariableSetApplyToWorkspacesOptions{
+			Workspaces: []*tfe.Workspace{workspace},
+		})
+		if err != nil {
+			logger.WithError(err).Warnf("failed to apply variable set %s", variableSetID)
+			sb.WriteString(fmt.Sprintf("- ❌ Variable set `%s`: failed - %v\n", variableSetID, err))
+		} else {
+			sb.WriteString(fmt.Sprintf("- ✅ Variable set `%s`: applied\n", variableSetID))
+		}
+	}
+
+	return sb.String()
+}
+
+// clientOverridesFromRequest builds per-call TFE client overrides from the optional
+// tfe_hostname/tfe_token/tfe_skip_tls_verify parameters, returning nil when none are set so the
+// client factory falls back to the server's default TFE_TOKEN/TFE_ADDRESS.
+func clientOverridesFromRequest(request mcp.CallToolRequest) *client.ClientOverrides {
+	hostname := request.GetString("tfe_hostname", "")
+	token := request.GetString("tfe_token", "")
+	skipTLSVerify := strings.ToLower(request.GetString("tfe_skip_tls_verify", "false")) == "true"
+
+	if hostname == "" && token == "" && !skipTLSVerify {
+		return nil
+	}
+
+	return &client.ClientOverrides{
+		Hostname:      hostname,
+		Token:         token,
+		SkipTLSVerify: skipTLSVerify,
+	}
+}
+
+// splitAndTrim splits a comma-separated string into a slice of trimmed, non-empty values.
+func splitAndTrim(s string) []string {
+	parts := strings.Split(s, ",")
+	result := make([]string, 0, len(parts))
+	for _, p := range parts {
+		p = strings.TrimSpace(p)
+		if p != "" {
+			result = append(result, p)
+		}
+	}
+	return result
+}
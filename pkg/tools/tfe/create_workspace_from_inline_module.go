@@ -0,0 +1,182 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-slug"
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// CreateWorkspaceFromInlineModule creates a tool that spins up a workspace and uploads a
+// configuration version built from user-supplied HCL, with no VCS repo or OAuth token required.
+func CreateWorkspaceFromInlineModule(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("create_workspace_from_inline_module",
+			mcp.WithDescription(`Creates a new Terraform workspace and uploads a configuration version built from inline HCL supplied in the request, rather than from a VCS repository. This is useful for spinning up throwaway workspaces to try out a small module without needing a git repo or OAuth token. The configuration version is created with auto-queue-runs disabled, so no run is started.`),
+			mcp.WithTitleAnnotation("Create a workspace from an inline Terraform module"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("The Terraform Cloud/Enterprise organization name"),
+			),
+			mcp.WithString("workspace_name",
+				mcp.Required(),
+				mcp.Description("The name of the workspace to create"),
+			),
+			mcp.WithString("main_tf",
+				mcp.Required(),
+				mcp.Description("The contents of the module's main.tf file"),
+			),
+			mcp.WithString("extra_files",
+				mcp.Description(`Optional JSON object mapping additional filenames to file contents (e.g. '{"variables.tf": "..."}') to include alongside main.tf in the configuration version`),
+			),
+			mcp.WithString("description",
+				mcp.Description("Optional description for the workspace"),
+			),
+			mcp.WithString("terraform_version",
+				mcp.Description("Optional Terraform version to use (e.g., '1.5.0')"),
+			),
+			mcp.WithString("project_id",
+				mcp.Description("Optional project ID to associate the workspace with"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return createWorkspaceFromInlineModuleHandler(ctx, request, logger)
+		},
+	}
+}
+
+func createWorkspaceFromInlineModuleHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	terraformOrgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	terraformOrgName = strings.TrimSpace(terraformOrgName)
+
+	workspaceName, err := request.RequireString("workspace_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: workspace_name", err)
+	}
+	workspaceName = strings.TrimSpace(workspaceName)
+
+	mainTf, err := request.RequireString("main_tf")
+	if err != nil {
+		return ToolError(logger, "missing required input: main_tf", err)
+	}
+
+	extraFilesStr := request.GetString("extra_files", "")
+	description := request.GetString("description", "")
+	terraformVersion := request.GetString("terraform_version", "")
+	projectID := request.GetString("project_id", "")
+
+	extraFiles := map[string]string{}
+	if extraFilesStr != "" {
+		if err := json.Unmarshal([]byte(extraFilesStr), &extraFiles); err != nil {
+			return ToolErrorf(logger, "extra_files must be a JSON object mapping filename to file contents: %v", err)
+		}
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client - ensure TFE_TOKEN and TFE_ADDRESS are configured", err)
+	}
+
+	options := tfe.WorkspaceCreateOptions{
+		Name:          &workspaceName,
+		ExecutionMode: tfe.String("remote"),
+		SourceName:    tfe.String(SourceName),
+	}
+	if description != "" {
+		options.Description = &description
+	}
+	if terraformVersion != "" {
+		options.TerraformVersion = &terraformVersion
+	}
+	if projectID != "" {
+		options.Project = &tfe.Project{ID: projectID}
+	}
+
+	createCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	workspace, err := tfeClient.Workspaces.Create(createCtx, terraformOrgName, options)
+	if err != nil {
+		return ToolErrorf(logger, "failed to create workspace '%s' in org '%s': %v", workspaceName, terraformOrgName, err)
+	}
+
+	slugBytes, err := packInlineModule(mainTf, extraFiles)
+	if err != nil {
+		return ToolErrorf(logger, "failed to package inline module for workspace '%s': %v", workspaceName, err)
+	}
+
+	cv, err := tfeClient.ConfigurationVersions.Create(ctx, workspace.ID, tfe.ConfigurationVersionCreateOptions{
+		AutoQueueRuns: tfe.Bool(false),
+	})
+	if err != nil {
+		return ToolErrorf(logger, "workspace '%s' was created but its configuration version could not be created: %v", workspaceName, err)
+	}
+
+	if err := tfeClient.ConfigurationVersions.Upload(ctx, cv.UploadURL, bytes.NewReader(slugBytes)); err != nil {
+		return ToolErrorf(logger, "workspace '%s' was created but the inline module upload failed: %v", workspaceName, err)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Created Workspace From Inline Module: %s/%s\n\n", terraformOrgName, workspaceName))
+	sb.WriteString(fmt.Sprintf("**Workspace ID:** %s\n", workspace.ID))
+	sb.WriteString(fmt.Sprintf("**Configuration Version ID:** %s\n", cv.ID))
+	sb.WriteString(fmt.Sprintf("**Configuration Version Status:** %s\n", cv.Status))
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+// packInlineModule writes main.tf and any extra files to a temporary directory and packages
+// them into a gzipped tar archive (a "slug") suitable for uploading as a configuration version.
+func packInlineModule(mainTf string, extraFiles map[string]string) ([]byte, error) {
+	tmpDir, err := os.MkdirTemp("", "inline-module-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp directory: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	if err := os.WriteFile(filepath.Join(tmpDir, "main.tf"), []byte(mainTf), 0o644); err != nil {
+		return nil, fmt.Errorf("failed to write main.tf: %w", err)
+	}
+
+	for name, contents := range extraFiles {
+		path := filepath.Join(tmpDir, filepath.Clean(name))
+		if !strings.HasPrefix(path, tmpDir) {
+			return nil, fmt.Errorf("invalid extra file name: %s", name)
+		}
+		if dir := filepath.Dir(path); dir != tmpDir {
+			if err := os.MkdirAll(dir, 0o755); err != nil {
+				return nil, fmt.Errorf("failed to create directory for %s: %w", name, err)
+			}
+		}
+		if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if _, err := slug.Pack(tmpDir, &buf, true); err != nil {
+		return nil, fmt.Errorf("failed to pack slug: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
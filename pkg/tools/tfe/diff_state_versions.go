@@ -0,0 +1,182 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// DiffStateVersions creates a tool to diff two state versions' resources, so agents can perform
+// drift analysis without pulling both full state blobs into the LLM context.
+func DiffStateVersions(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("diff_state_versions",
+			mcp.WithDescription(`Downloads two Terraform state versions and produces a per-resource-address diff of their attributes: resources added, removed, changed (with the changed attribute names), and a count of those left unchanged. Useful for comparing state before/after an apply, or across two points in a workspace's history.`),
+			mcp.WithTitleAnnotation("Diff two Terraform state versions"),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("state_version_id_a",
+				mcp.Required(),
+				mcp.Description("The ID of the first (baseline) state version"),
+			),
+			mcp.WithString("state_version_id_b",
+				mcp.Required(),
+				mcp.Description("The ID of the second (comparison) state version"),
+			),
+		),
+		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return diffStateVersionsHandler(ctx, req, logger)
+		},
+	}
+}
+
+func diffStateVersionsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	stateVersionIDA, err := request.RequireString("state_version_id_a")
+	if err != nil {
+		return ToolError(logger, "missing required input: state_version_id_a", err)
+	}
+
+	stateVersionIDB, err := request.RequireString("state_version_id_b")
+	if err != nil {
+		return ToolError(logger, "missing required input: state_version_id_b", err)
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	resourcesA, err := downloadStateResources(ctx, tfeClient, stateVersionIDA)
+	if err != nil {
+		return ToolErrorf(logger, "failed to download state version '%s': %v", stateVersionIDA, err)
+	}
+
+	resourcesB, err := downloadStateResources(ctx, tfeClient, stateVersionIDB)
+	if err != nil {
+		return ToolErrorf(logger, "failed to download state version '%s': %v", stateVersionIDB, err)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# State Diff: %s -> %s\n\n", stateVersionIDA, stateVersionIDB))
+
+	byAddressA := indexStateResourcesByAddress(resourcesA)
+	byAddressB := indexStateResourcesByAddress(resourcesB)
+
+	addresses := mergeKeys(toInterfaceMap(byAddressA), toInterfaceMap(byAddressB))
+
+	var added, removed, changed, unchanged []string
+	var changeDetails strings.Builder
+
+	for _, address := range addresses {
+		rA, inA := byAddressA[address]
+		rB, inB := byAddressB[address]
+
+		switch {
+		case !inA && inB:
+			added = append(added, address)
+		case inA && !inB:
+			removed = append(removed, address)
+		default:
+			changedAttrs := diffAttributeNames(rA.AttributeValues, rB.AttributeValues)
+			if len(changedAttrs) == 0 {
+				unchanged = append(unchanged, address)
+			} else {
+				changed = append(changed, address)
+				changeDetails.WriteString(fmt.Sprintf("- **%s**: %s\n", address, strings.Join(changedAttrs, ", ")))
+			}
+		}
+	}
+
+	sb.WriteString(fmt.Sprintf("**Added:** %d, **Removed:** %d, **Changed:** %d, **Unchanged:** %d\n", len(added), len(removed), len(changed), len(unchanged)))
+
+	if len(added) > 0 {
+		sb.WriteString("\n## Added\n\n")
+		for _, address := range added {
+			sb.WriteString(fmt.Sprintf("- %s\n", address))
+		}
+	}
+	if len(removed) > 0 {
+		sb.WriteString("\n## Removed\n\n")
+		for _, address := range removed {
+			sb.WriteString(fmt.Sprintf("- %s\n", address))
+		}
+	}
+	if len(changed) > 0 {
+		sb.WriteString("\n## Changed\n\n")
+		sb.WriteString(changeDetails.String())
+	}
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+// downloadStateResources downloads and parses a state version's full JSON state, returning its
+// flattened resource list (root module plus all child modules).
+func downloadStateResources(ctx context.Context, tfeClient *tfe.Client, stateVersionID string) ([]*tfjson.StateResource, error) {
+	sv, err := tfeClient.StateVersions.Read(ctx, stateVersionID)
+	if err != nil {
+		return nil, err
+	}
+	if sv.JSONDownloadURL == "" {
+		return nil, fmt.Errorf("state version has no JSON state available for download")
+	}
+
+	stateBytes, err := tfeClient.StateVersions.Download(ctx, sv.JSONDownloadURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var tfState tfjson.State
+	if err := json.Unmarshal(stateBytes, &tfState); err != nil {
+		return nil, fmt.Errorf("could not parse JSON state: %w", err)
+	}
+
+	var resources []*tfjson.StateResource
+	if tfState.Values != nil && tfState.Values.RootModule != nil {
+		collectStateResources(tfState.Values.RootModule, &resources)
+	}
+	return resources, nil
+}
+
+// indexStateResourcesByAddress builds a lookup of resources by their full address.
+func indexStateResourcesByAddress(resources []*tfjson.StateResource) map[string]*tfjson.StateResource {
+	byAddress := make(map[string]*tfjson.StateResource, len(resources))
+	for _, r := range resources {
+		byAddress[r.Address] = r
+	}
+	return byAddress
+}
+
+// toInterfaceMap adapts a map[string]*tfjson.StateResource to the map[string]interface{} shape
+// mergeKeys expects, so the two state's address sets can be merged and sorted with the same
+// helper used elsewhere for diffing.
+func toInterfaceMap(m map[string]*tfjson.StateResource) map[string]interface{} {
+	out := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	return out
+}
+
+// diffAttributeNames returns the sorted names of top-level attributes that differ between two
+// resources' attribute maps, including attributes added or removed entirely.
+func diffAttributeNames(a, b map[string]interface{}) []string {
+	names := mergeKeys(a, b)
+	var changed []string
+	for _, name := range names {
+		if !valuesEqual(a[name], b[name]) {
+			changed = append(changed, name)
+		}
+	}
+	return changed
+}
@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// DiscardRun creates a tool to discard a Terraform run that is awaiting confirmation.
+func DiscardRun(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("discard_run",
+			mcp.WithDescription(`Discards a Terraform run, skipping its apply. Use this to reject a plan you don't want applied, freeing the workspace to queue another run.`),
+			mcp.WithTitleAnnotation("Discard a Terraform run"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("run_id",
+				mcp.Required(),
+				mcp.Description("The ID of the run to discard"),
+			),
+			mcp.WithString("comment",
+				mcp.Description("Optional comment explaining why the run was discarded"),
+			),
+		),
+		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return discardRunHandler(ctx, req, logger)
+		},
+	}
+}
+
+func discardRunHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	runID, err := request.RequireString("run_id")
+	if err != nil {
+		return ToolError(logger, "missing required input: run_id", err)
+	}
+	comment := request.GetString("comment", "")
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	run, err := tfeClient.Runs.Read(ctx, runID)
+	if err != nil {
+		return ToolErrorf(logger, "run not found: %s", runID)
+	}
+	if !run.Actions.IsDiscardable {
+		return ToolErrorf(logger, "run %s is not discardable in its current status '%s'", runID, run.Status)
+	}
+
+	options := tfe.RunDiscardOptions{}
+	if comment != "" {
+		options.Comment = &comment
+	}
+
+	if err := tfeClient.Runs.Discard(ctx, runID, options); err != nil {
+		return ToolErrorf(logger, "failed to discard run %s: %v", runID, err)
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Run %s has been discarded.", runID)), nil
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
@@ -18,17 +19,23 @@ import (
 
 // GetApplyDetails creates a tool to get detailed apply information and logs for a Terraform run.
 func GetApplyDetails(logger *log.Logger) server.ServerTool {
-	return server.ServerTool{
-		Tool: mcp.NewTool("get_apply_details",
-			mcp.WithDescription(`Fetches the detailed apply results and logs for a Terraform run, showing what actually happened during the apply phase including any errors. Accepts a run ID, retrieves the associated apply, and returns the apply status, resource change counts, and the full apply log output.`),
-			mcp.WithTitleAnnotation("Get detailed apply results and logs for a Terraform run"),
-			mcp.WithReadOnlyHintAnnotation(true),
-			mcp.WithDestructiveHintAnnotation(false),
-			mcp.WithString("run_id",
-				mcp.Required(),
-				mcp.Description("The ID of the run to get apply details for"),
-			),
+	opts := []mcp.ToolOption{
+		mcp.WithDescription(`Fetches the detailed apply results and logs for a Terraform run, showing what actually happened during the apply phase including any errors. Accepts a run ID, retrieves the associated apply, and streams its log output rather than buffering it whole - use tail_lines/since_offset/follow to control how much of the log comes back and whether to wait for the apply to finish. Log output has ANSI color codes stripped, and any Error:/Warning: diagnostic blocks are parsed into a structured Diagnostics section alongside the raw log. Set errors_only='true' to return just the diagnostics, skipping the full log - useful when the log is many megabytes and only the failure reasons matter.`),
+		mcp.WithTitleAnnotation("Get detailed apply results and logs for a Terraform run"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithString("run_id",
+			mcp.Required(),
+			mcp.Description("The ID of the run to get apply details for"),
+		),
+		mcp.WithString("errors_only",
+			mcp.Description("Whether to return only the extracted diagnostics (errors/warnings) instead of the full log: 'true' or 'false' (default: 'false')"),
 		),
+	}
+	opts = append(opts, withLogStreamParams()...)
+
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_apply_details", opts...),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			return getApplyDetailsHandler(ctx, req, logger)
 		},
@@ -41,6 +48,12 @@ func getApplyDetailsHandler(ctx context.Context, request mcp.CallToolRequest, lo
 		return ToolError(logger, "missing required input: run_id", err)
 	}
 
+	opts, err := parseLogStreamOptions(request)
+	if err != nil {
+		return ToolError(logger, err.Error(), err)
+	}
+	errorsOnly := strings.ToLower(request.GetString("errors_only", "false")) == "true"
+
 	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
 	if err != nil {
 		return ToolError(logger, "failed to get Terraform client", err)
@@ -99,39 +112,38 @@ func getApplyDetailsHandler(ctx context.Context, request mcp.CallToolRequest, lo
 		return mcp.NewToolResultText(sb.String()), nil
 	}
 
-	// Fetch apply logs
-	logReader, err := tfeClient.Applies.Logs(ctx, apply.ID)
+	var logContent string
+	var offset int64
+	if opts.Follow {
+		logContent, offset, apply, err = followApplyLogs(ctx, request, tfeClient, apply, opts, logger)
+	} else {
+		var logReader io.Reader
+		logReader, err = tfeClient.Applies.Logs(ctx, apply.ID)
+		if err == nil {
+			logContent, offset, err = streamLogLines(ctx, request, logReader, opts, logger)
+		}
+	}
 	if err != nil {
-		logger.WithError(err).Warn("Could not fetch apply logs")
+		logger.WithError(err).Warn("Could not stream apply logs")
 		sb.WriteString("\n> **Note:** Could not retrieve apply logs.\n")
 		return mcp.NewToolResultText(sb.String()), nil
 	}
 
-	logBytes, err := io.ReadAll(logReader)
-	if err != nil {
-		logger.WithError(err).Warn("Could not read apply logs")
-		sb.WriteString("\n> **Note:** Could not read apply logs.\n")
+	sb.WriteString(fmt.Sprintf("**Apply Status (after streaming):** %s\n", apply.Status))
+
+	logContent = stripANSI(logContent)
+	diagnostics := extractDiagnostics(logContent)
+
+	if errorsOnly {
+		sb.WriteString(formatDiagnosticsSection(diagnostics))
+		sb.WriteString(fmt.Sprintf("\n*(log offset: %d - pass as since_offset to resume from here)*\n", offset))
 		return mcp.NewToolResultText(sb.String()), nil
 	}
 
-	logContent := string(logBytes)
-
 	if logContent == "" {
 		sb.WriteString("\n## Apply Logs\n\nNo log output available.\n")
 	} else {
-		// Truncate very large logs to keep the response reasonable
-		const maxLogSize = 50000
-		truncated := false
-		if len(logContent) > maxLogSize {
-			// Keep the last portion of the log (errors are usually at the end)
-			logContent = logContent[len(logContent)-maxLogSize:]
-			truncated = true
-		}
-
 		sb.WriteString("\n## Apply Logs\n\n")
-		if truncated {
-			sb.WriteString("*(Log output truncated -- showing last portion which typically contains errors)*\n\n")
-		}
 		sb.WriteString("```\n")
 		sb.WriteString(logContent)
 		if !strings.HasSuffix(logContent, "\n") {
@@ -139,6 +151,67 @@ func getApplyDetailsHandler(ctx context.Context, request mcp.CallToolRequest, lo
 		}
 		sb.WriteString("```\n")
 	}
+	sb.WriteString(formatDiagnosticsSection(diagnostics))
+	sb.WriteString(fmt.Sprintf("\n*(log offset: %d - pass as since_offset to resume from here)*\n", offset))
 
 	return mcp.NewToolResultText(sb.String()), nil
 }
+
+// followApplyLogs polls the apply's status with exponential backoff, streaming newly appended
+// log content (and a progress notification) after each poll, until the apply reaches a terminal
+// status or the context is canceled.
+func followApplyLogs(ctx context.Context, request mcp.CallToolRequest, tfeClient *tfe.Client, apply *tfe.Apply, opts logStreamOptions, logger *log.Logger) (string, int64, *tfe.Apply, error) {
+	var content strings.Builder
+	offset := opts.SinceOffset
+
+	wait := 2 * time.Second
+	const maxWait = 30 * time.Second
+
+	for {
+		logReader, err := tfeClient.Applies.Logs(ctx, apply.ID)
+		if err != nil {
+			return content.String(), offset, apply, err
+		}
+
+		chunkOpts := opts
+		chunkOpts.SinceOffset = offset
+		chunk, newOffset, err := streamLogLines(ctx, request, logReader, chunkOpts, logger)
+		if err != nil {
+			return content.String(), offset, apply, err
+		}
+		content.WriteString(chunk)
+		offset = newOffset
+
+		if isTerminalApplyStatus(apply.Status) {
+			return content.String(), offset, apply, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return content.String(), offset, apply, nil
+		case <-time.After(wait):
+		}
+
+		wait *= 2
+		if wait > maxWait {
+			wait = maxWait
+		}
+
+		refreshed, err := tfeClient.Applies.Read(ctx, apply.ID)
+		if err != nil {
+			logger.WithError(err).Warn("Could not refresh apply status while following logs")
+			return content.String(), offset, apply, nil
+		}
+		apply = refreshed
+	}
+}
+
+// isTerminalApplyStatus reports whether an apply has reached a status it will not move on from.
+func isTerminalApplyStatus(status tfe.ApplyStatus) bool {
+	switch status {
+	case tfe.ApplyFinished, tfe.ApplyErrored, tfe.ApplyCanceled, tfe.ApplyUnreachable:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// GetCostEstimate creates a tool to get the cost estimate for a Terraform run, giving agents
+// visibility into TFC/E cost estimation before they decide whether to trigger an apply.
+func GetCostEstimate(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_cost_estimate",
+			mcp.WithDescription(`Fetches the cost estimate for a Terraform run, showing the prior and proposed monthly cost, the delta between them, and how many resources were matched against cost data. Accepts a run ID, retrieves the associated cost estimate, and returns its status and figures.`),
+			mcp.WithTitleAnnotation("Get the cost estimate for a Terraform run"),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("run_id",
+				mcp.Required(),
+				mcp.Description("The ID of the run to get the cost estimate for"),
+			),
+		),
+		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getCostEstimateHandler(ctx, req, logger)
+		},
+	}
+}
+
+func getCostEstimateHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	runID, err := request.RequireString("run_id")
+	if err != nil {
+		return ToolError(logger, "missing required input: run_id", err)
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	// Read the run with the cost estimate relationship included so we get the cost estimate ID
+	run, err := tfeClient.Runs.ReadWithOptions(ctx, runID, &tfe.RunReadOptions{
+		Include: []tfe.RunIncludeOpt{tfe.RunCostEstimate},
+	})
+	if err != nil {
+		return ToolErrorf(logger, "run not found: %s", runID)
+	}
+
+	if run.CostEstimate == nil {
+		return ToolErrorf(logger, "no cost estimate associated with run: %s (run status: %s)", runID, run.Status)
+	}
+
+	costEstimate, err := tfeClient.CostEstimates.Read(ctx, run.CostEstimate.ID)
+	if err != nil {
+		return ToolErrorf(logger, "failed to read cost estimate %s: %v", run.CostEstimate.ID, err)
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("# Cost Estimate for Run %s\n\n", run.ID))
+	sb.WriteString(fmt.Sprintf("**Cost Estimate ID:** %s\n", costEstimate.ID))
+	sb.WriteString(fmt.Sprintf("**Status:** %s\n", costEstimate.Status))
+
+	switch costEstimate.Status {
+	case tfe.CostEstimatePending, tfe.CostEstimateQueued:
+		sb.WriteString(fmt.Sprintf("\n> **Note:** The cost estimate is not yet available because its status is `%s`. ", costEstimate.Status))
+		sb.WriteString("Re-run this tool after the run has advanced to see the full cost estimate.\n")
+		return mcp.NewToolResultText(sb.String()), nil
+	}
+
+	sb.WriteString(fmt.Sprintf("**Matched Resources:** %d\n", costEstimate.MatchedResourcesCount))
+	sb.WriteString(fmt.Sprintf("**Unmatched Resources:** %d\n", costEstimate.UnmatchedResourcesCount))
+	sb.WriteString(fmt.Sprintf("**Resources Considered:** %d\n", costEstimate.ResourcesCount))
+
+	if costEstimate.PriorMonthlyCost != "" {
+		sb.WriteString(fmt.Sprintf("**Prior Monthly Cost:** $%s\n", costEstimate.PriorMonthlyCost))
+	}
+	if costEstimate.ProposedMonthlyCost != "" {
+		sb.WriteString(fmt.Sprintf("**Proposed Monthly Cost:** $%s\n", costEstimate.ProposedMonthlyCost))
+	}
+	if costEstimate.DeltaMonthlyCost != "" {
+		sb.WriteString(fmt.Sprintf("**Delta Monthly Cost:** $%s\n", costEstimate.DeltaMonthlyCost))
+	}
+
+	if costEstimate.Status == tfe.CostEstimateErrored && costEstimate.ErrorMessage != "" {
+		sb.WriteString(fmt.Sprintf("\n> **Error:** %s\n", costEstimate.ErrorMessage))
+	}
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
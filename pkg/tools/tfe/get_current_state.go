@@ -7,10 +7,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path"
 	"strings"
 
 	"github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	tfjson "github.com/hashicorp/terraform-json"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	log "github.com/sirupsen/logrus"
@@ -36,6 +38,16 @@ func GetCurrentState(logger *log.Logger) server.ServerTool {
 				mcp.Description("Whether to include the full JSON state download. Set to 'true' for detailed resource attributes. Defaults to 'false' to return only metadata and outputs."),
 				mcp.DefaultString("false"),
 			),
+			mcp.WithString("resource_address_filter",
+				mcp.Description(`Only applies when include_full_state is 'true'. A glob pattern (e.g. "module.network.aws_s3_bucket.*") matched against each resource's full address, so only matching resources are included instead of the whole state.`),
+			),
+			mcp.WithString("include_dependencies",
+				mcp.Description("Only applies when include_full_state is 'true'. Whether to also emit an adjacency list of resource-to-resource depends_on edges from the state: 'true' or 'false' (default: 'false')"),
+			),
+			mcp.WithString("output_format",
+				mcp.Description(`Only applies when include_full_state is 'true'. Format for the full state (and dependency graph, if requested): "markdown" (default), "json" (structured resources/dependencies for downstream tooling), or "graphviz" (a DOT digraph of the dependency edges - requires include_dependencies='true')`),
+				mcp.DefaultString("markdown"),
+			),
 		),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			return getCurrentStateHandler(ctx, req, logger)
@@ -57,6 +69,15 @@ func getCurrentStateHandler(ctx context.Context, request mcp.CallToolRequest, lo
 	workspaceName = strings.TrimSpace(workspaceName)
 
 	includeFullState := strings.ToLower(strings.TrimSpace(request.GetString("include_full_state", "false"))) == "true"
+	resourceAddressFilter := strings.TrimSpace(request.GetString("resource_address_filter", ""))
+	includeDependencies := strings.ToLower(strings.TrimSpace(request.GetString("include_dependencies", "false"))) == "true"
+	outputFormat := strings.ToLower(strings.TrimSpace(request.GetString("output_format", "markdown")))
+	if outputFormat != "markdown" && outputFormat != "json" && outputFormat != "graphviz" {
+		return ToolErrorf(logger, "invalid output_format '%s' - must be 'markdown', 'json', or 'graphviz'", outputFormat)
+	}
+	if outputFormat == "graphviz" && !includeDependencies {
+		return ToolError(logger, "output_format 'graphviz' requires include_dependencies to be 'true'", nil)
+	}
 
 	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
 	if err != nil {
@@ -156,21 +177,22 @@ func getCurrentStateHandler(ctx context.Context, request mcp.CallToolRequest, lo
 			logger.WithError(err).Warn("Could not download full JSON state")
 			sb.WriteString("\n> **Note:** Could not download the full JSON state representation.\n")
 		} else {
-			// Pretty-print the JSON
-			var prettyJSON map[string]interface{}
-			if err := json.Unmarshal(stateBytes, &prettyJSON); err == nil {
-				formatted, err := json.MarshalIndent(prettyJSON, "", "  ")
-				if err == nil {
-					stateContent := string(formatted)
-
-					sb.WriteString("\n## Full JSON State\n\n")
-					sb.WriteString("```json\n")
-					sb.WriteString(stateContent)
-					if !strings.HasSuffix(stateContent, "\n") {
-						sb.WriteString("\n")
-					}
-					sb.WriteString("```\n")
+			var tfState tfjson.State
+			if err := json.Unmarshal(stateBytes, &tfState); err != nil {
+				logger.WithError(err).Warn("Could not parse full JSON state")
+				sb.WriteString("\n> **Note:** Could not parse the full JSON state representation.\n")
+			} else {
+				resources, err := filterStateResources(&tfState, resourceAddressFilter)
+				if err != nil {
+					return ToolErrorf(logger, "invalid resource_address_filter '%s': %v", resourceAddressFilter, err)
+				}
+
+				var dependencies []resourceDependencyEdge
+				if includeDependencies {
+					dependencies = buildResourceDependencyEdges(resources)
 				}
+
+				writeFullStateSection(&sb, sv.ID, resources, dependencies, includeDependencies, outputFormat)
 			}
 		}
 	}
@@ -178,6 +200,221 @@ func getCurrentStateHandler(ctx context.Context, request mcp.CallToolRequest, lo
 	return mcp.NewToolResultText(sb.String()), nil
 }
 
+// resourceDependencyEdge is one resource-to-resource depends_on edge from the state.
+type resourceDependencyEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// structuredStateResource is one entry of the structured (output_format="json") full-state
+// response, trimmed down to what's useful for drift analysis without the rest of
+// tfjson.StateResource's bookkeeping fields.
+type structuredStateResource struct {
+	Address  string                 `json:"address"`
+	Mode     string                 `json:"mode"`
+	Type     string                 `json:"type"`
+	Name     string                 `json:"name"`
+	Provider string                 `json:"provider_name"`
+	Values   map[string]interface{} `json:"values,omitempty"`
+}
+
+// structuredStateResponse is the documented schema returned for output_format="json".
+type structuredStateResponse struct {
+	StateVersionID string                    `json:"state_version_id"`
+	Resources      []structuredStateResource `json:"resources"`
+	Dependencies   []resourceDependencyEdge  `json:"dependencies,omitempty"`
+}
+
+// filterStateResources flattens the state's root module and all child modules into a single
+// list of resources (terraform-json already qualifies each resource's Address with its module
+// path), optionally keeping only those whose address matches the given glob pattern.
+func filterStateResources(state *tfjson.State, addressFilter string) ([]*tfjson.StateResource, error) {
+	var all []*tfjson.StateResource
+	if state.Values != nil && state.Values.RootModule != nil {
+		collectStateResources(state.Values.RootModule, &all)
+	}
+
+	if addressFilter == "" {
+		return all, nil
+	}
+
+	var filtered []*tfjson.StateResource
+	for _, r := range all {
+		matched, err := path.Match(addressFilter, r.Address)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			filtered = append(filtered, r)
+		}
+	}
+	return filtered, nil
+}
+
+// collectStateResources recursively appends a module's resources, and those of all its child
+// modules, to all.
+func collectStateResources(module *tfjson.StateModule, all *[]*tfjson.StateResource) {
+	*all = append(*all, module.Resources...)
+	for _, child := range module.ChildModules {
+		collectStateResources(child, all)
+	}
+}
+
+// buildResourceDependencyEdges builds a resource-to-resource adjacency list from each resource's
+// depends_on addresses, restricted to the given resource set (so filtering by
+// resource_address_filter also narrows the dependency graph).
+func buildResourceDependencyEdges(resources []*tfjson.StateResource) []resourceDependencyEdge {
+	inSet := make(map[string]bool, len(resources))
+	for _, r := range resources {
+		inSet[r.Address] = true
+	}
+
+	var edges []resourceDependencyEdge
+	for _, r := range resources {
+		for _, dep := range r.DependsOn {
+			if inSet[dep] {
+				edges = append(edges, resourceDependencyEdge{From: r.Address, To: dep})
+			}
+		}
+	}
+	return edges
+}
+
+// redactSensitiveStateValues returns a copy of a resource's attribute values with anything
+// marked sensitive in sensitiveValues (the state's sensitive_values marker tree, shaped the same
+// way as a plan's before_sensitive/after_sensitive) replaced by "(sensitive value)". It reuses
+// markerSensitive/markerFor from get_plan_details.go so nested sensitive attributes - e.g. one
+// field of a config map - are masked without hiding the rest of that map.
+func redactSensitiveStateValues(values map[string]interface{}, sensitiveValues json.RawMessage) map[string]interface{} {
+	if len(values) == 0 {
+		return values
+	}
+
+	var markers map[string]interface{}
+	if len(sensitiveValues) > 0 {
+		_ = json.Unmarshal(sensitiveValues, &markers)
+	}
+
+	redacted := make(map[string]interface{}, len(values))
+	for key, val := range values {
+		marker := markerFor(markers, key)
+		if markerSensitive(marker) {
+			redacted[key] = "(sensitive value)"
+			continue
+		}
+		redacted[key] = redactNestedSensitiveValue(val, marker)
+	}
+	return redacted
+}
+
+// redactNestedSensitiveValue recurses into a map or list, replacing any element whose marker
+// subtree is sensitive. redactSensitiveStateValues only calls it for values whose marker subtree
+// holds no `true`, so there it returns the values unchanged.
+func redactNestedSensitiveValue(val interface{}, marker interface{}) interface{} {
+	switch v := val.(type) {
+	case map[string]interface{}:
+		m, ok := marker.(map[string]interface{})
+		if !ok {
+			return v
+		}
+		out := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			itemMarker := markerFor(m, key)
+			if markerSensitive(itemMarker) {
+				out[key] = "(sensitive value)"
+				continue
+			}
+			out[key] = redactNestedSensitiveValue(item, itemMarker)
+		}
+		return out
+	case []interface{}:
+		s, ok := marker.([]interface{})
+		if !ok {
+			return v
+		}
+		out := make([]interface{}, len(v))
+		for i, item := range v {
+			var itemMarker interface{}
+			if i < len(s) {
+				itemMarker = s[i]
+			}
+			if markerSensitive(itemMarker) {
+				out[i] = "(sensitive value)"
+				continue
+			}
+			out[i] = redactNestedSensitiveValue(item, itemMarker)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// writeFullStateSection renders the filtered resources (and dependency edges, if requested) in
+// the requested output_format.
+func writeFullStateSection(sb *strings.Builder, stateVersionID string, resources []*tfjson.StateResource, dependencies []resourceDependencyEdge, includeDependencies bool, outputFormat string) {
+	switch outputFormat {
+	case "graphviz":
+		sb.WriteString("\n## Resource Dependency Graph\n\n")
+		sb.WriteString("```dot\n")
+		sb.WriteString("digraph resource_dependencies {\n")
+		for _, edge := range dependencies {
+			sb.WriteString(fmt.Sprintf("  %q -> %q;\n", edge.From, edge.To))
+		}
+		sb.WriteString("}\n")
+		sb.WriteString("```\n")
+		return
+	case "json":
+		response := structuredStateResponse{
+			StateVersionID: stateVersionID,
+			Dependencies:   dependencies,
+		}
+		for _, r := range resources {
+			response.Resources = append(response.Resources, structuredStateResource{
+				Address:  r.Address,
+				Mode:     string(r.Mode),
+				Type:     r.Type,
+				Name:     r.Name,
+				Provider: r.ProviderName,
+				Values:   redactSensitiveStateValues(r.AttributeValues, r.SensitiveValues),
+			})
+		}
+		encoded, err := json.MarshalIndent(response, "", "  ")
+		if err == nil {
+			sb.WriteString("\n## Full JSON State\n\n")
+			sb.WriteString("```json\n")
+			sb.WriteString(string(encoded))
+			sb.WriteString("\n```\n")
+		}
+		return
+	}
+
+	// markdown (default)
+	sb.WriteString(fmt.Sprintf("\n## Full State Resources (%d)\n\n", len(resources)))
+	for _, r := range resources {
+		sb.WriteString(fmt.Sprintf("### %s\n\n", r.Address))
+		if len(r.AttributeValues) > 0 {
+			data, err := json.MarshalIndent(redactSensitiveStateValues(r.AttributeValues, r.SensitiveValues), "", "  ")
+			if err == nil {
+				sb.WriteString("```json\n")
+				sb.WriteString(string(data))
+				sb.WriteString("\n```\n\n")
+			}
+		}
+	}
+
+	if includeDependencies {
+		sb.WriteString("\n## Resource Dependencies\n\n")
+		if len(dependencies) == 0 {
+			sb.WriteString("No depends_on edges found among the selected resources.\n")
+		} else {
+			for _, edge := range dependencies {
+				sb.WriteString(fmt.Sprintf("- %s -> %s\n", edge.From, edge.To))
+			}
+		}
+	}
+}
+
 // formatOutputValue converts an output value to a readable string representation.
 func formatOutputValue(value interface{}) string {
 	if value == nil {
@@ -0,0 +1,166 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// GetDriftReport creates a tool that surfaces only the drift detected in a run's plan, grouped
+// by resource type and module, without the surrounding proposed-change noise.
+func GetDriftReport(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_drift_report",
+			mcp.WithDescription(`Reports only the resource drift detected in a Terraform plan - changes that happened outside of Terraform, most commonly surfaced by refresh-only plans. Accepts either a run_id, or a workspace_id (in which case it looks up the workspace's latest completed plan). Returns a clear "no drift detected" message when none is present, rather than an empty section.`),
+			mcp.WithTitleAnnotation("Get the detected drift for a Terraform run or workspace"),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("run_id",
+				mcp.Description("The ID of the run to get the drift report for. Mutually exclusive with workspace_id."),
+			),
+			mcp.WithString("workspace_id",
+				mcp.Description("The ID of the workspace to get the latest completed plan's drift report for. Mutually exclusive with run_id."),
+			),
+		),
+		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getDriftReportHandler(ctx, req, logger)
+		},
+	}
+}
+
+func getDriftReportHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	runID := strings.TrimSpace(request.GetString("run_id", ""))
+	workspaceID := strings.TrimSpace(request.GetString("workspace_id", ""))
+
+	if runID == "" && workspaceID == "" {
+		return ToolError(logger, "either run_id or workspace_id must be provided", nil)
+	}
+	if runID != "" && workspaceID != "" {
+		return ToolError(logger, "run_id and workspace_id are mutually exclusive - provide only one", nil)
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	var run *tfe.Run
+	if runID != "" {
+		run, err = tfeClient.Runs.ReadWithOptions(ctx, runID, &tfe.RunReadOptions{
+			Include: []tfe.RunIncludeOpt{tfe.RunPlan},
+		})
+		if err != nil {
+			return ToolErrorf(logger, "run not found: %s", runID)
+		}
+	} else {
+		run, err = latestCompletedPlanRun(ctx, tfeClient, workspaceID)
+		if err != nil {
+			return ToolErrorf(logger, "could not find a completed plan for workspace '%s': %v", workspaceID, err)
+		}
+	}
+
+	if run.Plan == nil {
+		return ToolErrorf(logger, "no plan associated with run: %s", run.ID)
+	}
+
+	parsed, err := fetchParsedJSONPlan(ctx, tfeClient, run.Plan.ID, logger)
+	if err != nil {
+		return ToolErrorf(logger, "could not fetch the JSON plan output for run %s: %v", run.ID, err)
+	}
+	if parsed == nil {
+		return ToolErrorf(logger, "the plan for run %s has not finished yet - try again once it completes", run.ID)
+	}
+
+	return buildDriftReportResponse(run, parsed), nil
+}
+
+// latestCompletedPlanRun returns the most recent run on a workspace whose plan has actually
+// finished, newest first, falling back to older runs if the newest one's plan is still in
+// progress.
+func latestCompletedPlanRun(ctx context.Context, tfeClient *tfe.Client, workspaceID string) (*tfe.Run, error) {
+	runs, err := tfeClient.Runs.List(ctx, workspaceID, &tfe.RunListOptions{
+		ListOptions: tfe.ListOptions{PageSize: 20},
+		Include:     []tfe.RunIncludeOpt{tfe.RunPlan},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(runs.Items, func(i, j int) bool {
+		return runs.Items[i].CreatedAt.After(runs.Items[j].CreatedAt)
+	})
+
+	for _, r := range runs.Items {
+		if r.Plan == nil {
+			continue
+		}
+
+		full, err := tfeClient.Runs.ReadWithOptions(ctx, r.ID, &tfe.RunReadOptions{
+			Include: []tfe.RunIncludeOpt{tfe.RunPlan},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if full.Plan != nil && full.Plan.Status == tfe.PlanFinished {
+			return full, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no completed plan found")
+}
+
+// buildDriftReportResponse renders just the resource-drift section of a plan, grouped by
+// resource type and module, using the same "detected drift" language as GetPlanDetails.
+func buildDriftReportResponse(run *tfe.Run, parsed *jsonPlan) *mcp.CallToolResult {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("# Drift Report for Run %s\n\n", run.ID))
+
+	if len(parsed.ResourceDrift) == 0 {
+		sb.WriteString("No drift detected - all resources match their last known Terraform state.\n")
+		return mcp.NewToolResultText(sb.String())
+	}
+
+	sb.WriteString(fmt.Sprintf("**%d resources changed outside of Terraform**\n", len(parsed.ResourceDrift)))
+
+	groups := groupDriftByModuleAndType(parsed.ResourceDrift)
+	groupKeys := make([]string, 0, len(groups))
+	for key := range groups {
+		groupKeys = append(groupKeys, key)
+	}
+	sort.Strings(groupKeys)
+
+	for _, key := range groupKeys {
+		sb.WriteString(fmt.Sprintf("\n## %s\n\n", key))
+		for _, rc := range groups[key] {
+			writeResourceChange(&sb, &rc, DetectedDrift)
+		}
+	}
+
+	return mcp.NewToolResultText(sb.String())
+}
+
+// groupDriftByModuleAndType buckets drift entries by "<module>: <resource type>" so agents can
+// scan drift concentrated in one module or provider without reading the whole list.
+func groupDriftByModuleAndType(drift []resourceChange) map[string][]resourceChange {
+	groups := make(map[string][]resourceChange)
+	for _, rc := range drift {
+		module := rc.ModuleAddress
+		if module == "" {
+			module = "(root)"
+		}
+		key := fmt.Sprintf("%s: %s", module, rc.Type)
+		groups[key] = append(groups[key], rc)
+	}
+	return groups
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-tfe"
 	"github.com/hashicorp/terraform-mcp-server/pkg/client"
@@ -30,15 +31,16 @@ type jsonPlan struct {
 }
 
 type resourceChange struct {
-	Address       string `json:"address"`
-	PrevAddress   string `json:"previous_address,omitempty"`
-	ModuleAddress string `json:"module_address,omitempty"`
-	Mode          string `json:"mode"`
-	Type          string `json:"type"`
-	Name          string `json:"name"`
-	Index         any    `json:"index,omitempty"`
-	Change        change `json:"change"`
-	ActionReason  string `json:"action_reason,omitempty"`
+	Address       string        `json:"address"`
+	PrevAddress   string        `json:"previous_address,omitempty"`
+	ModuleAddress string        `json:"module_address,omitempty"`
+	Mode          string        `json:"mode"`
+	Type          string        `json:"type"`
+	Name          string        `json:"name"`
+	Index         any           `json:"index,omitempty"`
+	Change        change        `json:"change"`
+	ActionReason  string        `json:"action_reason,omitempty"`
+	ReplacePaths  []interface{} `json:"replace_paths,omitempty"`
 }
 
 type change struct {
@@ -54,6 +56,17 @@ type outputChange struct {
 	Change change `json:"change"`
 }
 
+// diffLanguage distinguishes rendering a planned change Terraform intends to make from
+// rendering drift that was detected against what's already happened outside Terraform. The two
+// read very differently: a planned update "will be updated", but a drifted update already "has
+// changed" - there's no action symbol for something that's already happened.
+type diffLanguage int
+
+const (
+	ProposedChange diffLanguage = iota
+	DetectedDrift
+)
+
 // GetPlanDetails creates a tool to get the detailed execution plan for a Terraform run.
 func GetPlanDetails(logger *log.Logger) server.ServerTool {
 	return server.ServerTool{
@@ -66,6 +79,19 @@ func GetPlanDetails(logger *log.Logger) server.ServerTool {
 				mcp.Required(),
 				mcp.Description("The ID of the run to get plan details for"),
 			),
+			mcp.WithString("format",
+				mcp.Description(`Output format: "markdown" (default, human-readable) or "json" (a stable structured schema - a top-level summary of counts, a resource_changes array, and an output_changes array - for downstream tools and agents to key off instead of parsing Markdown)`),
+				mcp.DefaultString("markdown"),
+			),
+			mcp.WithString("tail_lines",
+				mcp.Description("When the full JSON plan isn't available yet and plan logs are returned instead, limit the log to the last N lines, bounded by a ring buffer instead of a flat byte truncation"),
+			),
+			mcp.WithString("since_offset",
+				mcp.Description("Byte offset into the plan log to resume from, as returned by a previous call - lets a follow=true poll loop fetch only newly appended content"),
+			),
+			mcp.WithString("follow",
+				mcp.Description(`Whether to poll until the plan reaches a terminal status, emitting incremental progress notifications as new log content arrives: 'true' or 'false' (default: 'false'). Only applies when plan logs (not the structured JSON plan) are being returned.`),
+			),
 		),
 		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			return getPlanDetailsHandler(ctx, req, logger)
@@ -79,6 +105,16 @@ func getPlanDetailsHandler(ctx context.Context, request mcp.CallToolRequest, log
 		return ToolError(logger, "missing required input: run_id", err)
 	}
 
+	format := strings.ToLower(strings.TrimSpace(request.GetString("format", "markdown")))
+	if format != "markdown" && format != "json" {
+		return ToolErrorf(logger, "invalid format '%s' - must be 'markdown' or 'json'", format)
+	}
+
+	logOpts, err := parseLogStreamOptions(request)
+	if err != nil {
+		return ToolError(logger, err.Error(), err)
+	}
+
 	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
 	if err != nil {
 		return ToolError(logger, "failed to get Terraform client", err)
@@ -98,34 +134,54 @@ func getPlanDetailsHandler(ctx context.Context, request mcp.CallToolRequest, log
 
 	plan := run.Plan
 
-	// Try to fetch the full JSON execution plan
-	jsonOutput, err := tfeClient.Plans.ReadJSONOutput(ctx, plan.ID)
+	parsed, err := fetchParsedJSONPlan(ctx, tfeClient, plan.ID, logger)
 	if err != nil {
-		// JSON output may be unavailable (plan not finished, old TF version, etc.)
-		// Fall back to returning basic plan metadata + logs
-		logger.WithError(err).Warn("Could not fetch JSON plan output, falling back to plan metadata")
-		return buildPlanMetadataResponse(ctx, run, plan, tfeClient, logger), nil
+		// JSON output may be unavailable (plan not finished, old TF version, etc.) — fall back
+		// to returning basic plan metadata + logs.
+		return buildPlanMetadataResponse(ctx, request, run, plan, tfeClient, logOpts, logger), nil
+	}
+	if parsed == nil {
+		// 204 No Content — plan hasn't completed yet
+		return buildPlanMetadataResponse(ctx, request, run, plan, tfeClient, logOpts, logger), nil
+	}
+
+	if format == "json" {
+		result, err := buildStructuredPlanResponse(run, plan, parsed)
+		if err != nil {
+			return ToolErrorf(logger, "failed to build JSON plan response: %v", err)
+		}
+		return result, nil
 	}
 
+	return buildFormattedPlanResponse(run, plan, parsed), nil
+}
+
+// fetchParsedJSONPlan fetches and parses the full JSON execution plan for a plan ID, shared by
+// GetPlanDetails and GetDriftReport. It returns (nil, nil) when the plan hasn't completed yet
+// (204 No Content), and a non-nil error when the output couldn't be fetched or parsed.
+func fetchParsedJSONPlan(ctx context.Context, tfeClient *tfe.Client, planID string, logger *log.Logger) (*jsonPlan, error) {
+	jsonOutput, err := tfeClient.Plans.ReadJSONOutput(ctx, planID)
+	if err != nil {
+		logger.WithError(err).Warn("Could not fetch JSON plan output")
+		return nil, err
+	}
 	if len(jsonOutput) == 0 {
-		// 204 No Content — plan hasn't completed yet
-		return buildPlanMetadataResponse(ctx, run, plan, tfeClient, logger), nil
+		return nil, nil
 	}
 
-	// Parse the JSON execution plan
 	var parsed jsonPlan
 	if err := json.Unmarshal(jsonOutput, &parsed); err != nil {
-		logger.WithError(err).Warn("Could not parse JSON plan output, falling back to plan metadata")
-		return buildPlanMetadataResponse(ctx, run, plan, tfeClient, logger), nil
+		logger.WithError(err).Warn("Could not parse JSON plan output")
+		return nil, err
 	}
 
-	return buildFormattedPlanResponse(run, plan, &parsed), nil
+	return &parsed, nil
 }
 
 // buildPlanMetadataResponse returns a summary when the full JSON plan is unavailable.
-// It also fetches plan logs when the plan has errored or completed, providing
-// visibility into errors and other diagnostic output.
-func buildPlanMetadataResponse(ctx context.Context, run *tfe.Run, plan *tfe.Plan, tfeClient *tfe.Client, logger *log.Logger) *mcp.CallToolResult {
+// It also streams plan logs when the plan has errored or completed, providing visibility into
+// errors and other diagnostic output without buffering the whole log up front.
+func buildPlanMetadataResponse(ctx context.Context, request mcp.CallToolRequest, run *tfe.Run, plan *tfe.Plan, tfeClient *tfe.Client, logOpts logStreamOptions, logger *log.Logger) *mcp.CallToolResult {
 	var sb strings.Builder
 
 	sb.WriteString(fmt.Sprintf("# Plan Details for Run %s\n\n", run.ID))
@@ -146,43 +202,35 @@ func buildPlanMetadataResponse(ctx context.Context, run *tfe.Run, plan *tfe.Plan
 		return mcp.NewToolResultText(sb.String())
 	}
 
-	// For errored, canceled, running, or finished plans, fetch the logs
-	logReader, err := tfeClient.Plans.Logs(ctx, plan.ID)
+	var logContent string
+	var offset int64
+	var err error
+	if logOpts.Follow {
+		logContent, offset, plan, err = followPlanLogs(ctx, request, tfeClient, plan, logOpts, logger)
+	} else {
+		var logReader io.Reader
+		logReader, err = tfeClient.Plans.Logs(ctx, plan.ID)
+		if err == nil {
+			logContent, offset, err = streamLogLines(ctx, request, logReader, logOpts, logger)
+		}
+	}
 	if err != nil {
-		logger.WithError(err).Warn("Could not fetch plan logs")
+		logger.WithError(err).Warn("Could not stream plan logs")
 		if plan.Status == "errored" {
 			sb.WriteString("\n> **Note:** The plan errored but logs could not be retrieved.\n")
 		}
 		return mcp.NewToolResultText(sb.String())
 	}
 
-	logBytes, err := io.ReadAll(logReader)
-	if err != nil {
-		logger.WithError(err).Warn("Could not read plan logs")
-		return mcp.NewToolResultText(sb.String())
-	}
-
-	logContent := string(logBytes)
-
 	if logContent != "" {
-		// Truncate very large logs, keeping the tail (errors are usually at the end)
-		const maxLogSize = 50000
-		truncated := false
-		if len(logContent) > maxLogSize {
-			logContent = logContent[len(logContent)-maxLogSize:]
-			truncated = true
-		}
-
 		sb.WriteString("\n## Plan Logs\n\n")
-		if truncated {
-			sb.WriteString("*(Log output truncated -- showing last portion which typically contains errors)*\n\n")
-		}
 		sb.WriteString("```\n")
 		sb.WriteString(logContent)
 		if !strings.HasSuffix(logContent, "\n") {
 			sb.WriteString("\n")
 		}
 		sb.WriteString("```\n")
+		sb.WriteString(fmt.Sprintf("\n*(log offset: %d - pass as since_offset to resume from here)*\n", offset))
 	} else if plan.Status == "errored" {
 		sb.WriteString("\n> **Note:** The plan errored but no log output is available.\n")
 	}
@@ -190,6 +238,65 @@ func buildPlanMetadataResponse(ctx context.Context, run *tfe.Run, plan *tfe.Plan
 	return mcp.NewToolResultText(sb.String())
 }
 
+// followPlanLogs polls the plan's status with exponential backoff, streaming newly appended log
+// content (and a progress notification) after each poll, until the plan reaches a terminal
+// status or the context is canceled.
+func followPlanLogs(ctx context.Context, request mcp.CallToolRequest, tfeClient *tfe.Client, plan *tfe.Plan, opts logStreamOptions, logger *log.Logger) (string, int64, *tfe.Plan, error) {
+	var content strings.Builder
+	offset := opts.SinceOffset
+
+	wait := 2 * time.Second
+	const maxWait = 30 * time.Second
+
+	for {
+		logReader, err := tfeClient.Plans.Logs(ctx, plan.ID)
+		if err != nil {
+			return content.String(), offset, plan, err
+		}
+
+		chunkOpts := opts
+		chunkOpts.SinceOffset = offset
+		chunk, newOffset, err := streamLogLines(ctx, request, logReader, chunkOpts, logger)
+		if err != nil {
+			return content.String(), offset, plan, err
+		}
+		content.WriteString(chunk)
+		offset = newOffset
+
+		if isTerminalPlanStatus(plan.Status) {
+			return content.String(), offset, plan, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return content.String(), offset, plan, nil
+		case <-time.After(wait):
+		}
+
+		wait *= 2
+		if wait > maxWait {
+			wait = maxWait
+		}
+
+		refreshed, err := tfeClient.Plans.Read(ctx, plan.ID)
+		if err != nil {
+			logger.WithError(err).Warn("Could not refresh plan status while following logs")
+			return content.String(), offset, plan, nil
+		}
+		plan = refreshed
+	}
+}
+
+// isTerminalPlanStatus reports whether a plan has reached a status it will not move on from.
+func isTerminalPlanStatus(status tfe.PlanStatus) bool {
+	switch status {
+	case tfe.PlanFinished, tfe.PlanErrored, tfe.PlanCanceled, tfe.PlanUnreachable:
+		return true
+	default:
+		return false
+	}
+}
+
 // buildFormattedPlanResponse builds a formatted response from the parsed JSON plan.
 func buildFormattedPlanResponse(run *tfe.Run, plan *tfe.Plan, parsed *jsonPlan) *mcp.CallToolResult {
 	var sb strings.Builder
@@ -207,28 +314,32 @@ func buildFormattedPlanResponse(run *tfe.Run, plan *tfe.Plan, parsed *jsonPlan)
 	}
 
 	// Summary counts
-	adds, changes, destroys, replaces, noops := countActions(parsed.ResourceChanges)
+	adds, changes, destroys, replaces, moves, noops := countActions(parsed.ResourceChanges)
 	sb.WriteString(fmt.Sprintf("\n## Summary: %d to add, %d to change, %d to destroy", adds, changes, destroys))
 	if replaces > 0 {
 		sb.WriteString(fmt.Sprintf(", %d to replace", replaces))
 	}
+	if moves > 0 {
+		sb.WriteString(fmt.Sprintf(", %d to move", moves))
+	}
 	sb.WriteString("\n")
 
-	// Resource drift section
+	// Resource drift section — rendered with past-tense "detected drift" language, since this
+	// describes what has already happened outside Terraform, not what the plan proposes.
 	if len(parsed.ResourceDrift) > 0 {
-		sb.WriteString(fmt.Sprintf("\n## Resource Drift (%d detected)\n\n", len(parsed.ResourceDrift)))
-		sb.WriteString("Changes detected outside of Terraform:\n\n")
+		sb.WriteString(fmt.Sprintf("\n## Resource Drift: %d resources changed outside of Terraform\n\n", len(parsed.ResourceDrift)))
 		for _, rc := range parsed.ResourceDrift {
-			writeResourceChange(&sb, &rc)
+			writeResourceChange(&sb, &rc, DetectedDrift)
 		}
 	}
 
 	// Resource changes section
 	if len(parsed.ResourceChanges) > 0 {
-		// Separate no-ops from actual changes for clarity
+		// Separate no-ops from actual changes for clarity. A pure move (no-op with a
+		// previous_address) is not a no-op as far as the user is concerned, so it's kept.
 		var actualChanges []resourceChange
 		for _, rc := range parsed.ResourceChanges {
-			action := summarizeActions(rc.Change.Actions)
+			action := classifyResourceAction(&rc)
 			if action != "no-op" && action != "read" {
 				actualChanges = append(actualChanges, rc)
 			}
@@ -237,7 +348,7 @@ func buildFormattedPlanResponse(run *tfe.Run, plan *tfe.Plan, parsed *jsonPlan)
 		if len(actualChanges) > 0 {
 			sb.WriteString(fmt.Sprintf("\n## Resource Changes (%d)\n\n", len(actualChanges)))
 			for _, rc := range actualChanges {
-				writeResourceChange(&sb, &rc)
+				writeResourceChange(&sb, &rc, ProposedChange)
 			}
 		}
 
@@ -260,10 +371,118 @@ func buildFormattedPlanResponse(run *tfe.Run, plan *tfe.Plan, parsed *jsonPlan)
 	return mcp.NewToolResultText(sb.String())
 }
 
-// countActions tallies the different action types across all resource changes.
-func countActions(changes []resourceChange) (adds, updates, destroys, replaces, noops int) {
+// planSummary is the top-level counts block of the structured JSON plan response.
+type planSummary struct {
+	Add     int `json:"add"`
+	Change  int `json:"change"`
+	Destroy int `json:"destroy"`
+	Replace int `json:"replace"`
+	Move    int `json:"move"`
+	Drift   int `json:"drift"`
+}
+
+// planResourceChange is one entry of the structured JSON plan response's resource_changes array.
+type planResourceChange struct {
+	Address         string `json:"address"`
+	PreviousAddress string `json:"previous_address,omitempty"`
+	Action          string `json:"action"`
+	Reason          string `json:"reason,omitempty"`
+	SensitiveBefore bool   `json:"sensitive_before"`
+	SensitiveAfter  bool   `json:"sensitive_after"`
+	DiffSummary     string `json:"diff_summary"`
+}
+
+// planOutputChange is one entry of the structured JSON plan response's output_changes array.
+type planOutputChange struct {
+	Name   string `json:"name"`
+	Action string `json:"action"`
+}
+
+// structuredPlanResponse is the stable, documented schema returned when format="json", modeled
+// after Terraform's own streaming JSON-log messages so downstream MCP clients can key off fixed
+// fields instead of regex-parsing Markdown.
+type structuredPlanResponse struct {
+	RunID           string               `json:"run_id"`
+	PlanID          string               `json:"plan_id"`
+	PlanStatus      string               `json:"plan_status"`
+	Applyable       bool                 `json:"applyable"`
+	Summary         planSummary          `json:"summary"`
+	ResourceChanges []planResourceChange `json:"resource_changes"`
+	OutputChanges   []planOutputChange   `json:"output_changes"`
+}
+
+// buildStructuredPlanResponse renders the same parsed plan as buildFormattedPlanResponse into
+// the documented JSON schema instead of Markdown.
+func buildStructuredPlanResponse(run *tfe.Run, plan *tfe.Plan, parsed *jsonPlan) (*mcp.CallToolResult, error) {
+	adds, changes, destroys, replaces, moves, _ := countActions(parsed.ResourceChanges)
+
+	response := structuredPlanResponse{
+		RunID:      run.ID,
+		PlanID:     plan.ID,
+		PlanStatus: string(plan.Status),
+		Applyable:  parsed.Applyable,
+		Summary: planSummary{
+			Add:     adds,
+			Change:  changes,
+			Destroy: destroys,
+			Replace: replaces,
+			Move:    moves,
+			Drift:   len(parsed.ResourceDrift),
+		},
+	}
+
+	for _, rc := range parsed.ResourceChanges {
+		response.ResourceChanges = append(response.ResourceChanges, toPlanResourceChange(&rc, ProposedChange))
+	}
+	for _, rc := range parsed.ResourceDrift {
+		response.ResourceChanges = append(response.ResourceChanges, toPlanResourceChange(&rc, DetectedDrift))
+	}
+
+	for name, oc := range parsed.OutputChanges {
+		response.OutputChanges = append(response.OutputChanges, planOutputChange{
+			Name:   name,
+			Action: summarizeActions(oc.Change.Actions),
+		})
+	}
+
+	encoded, err := json.MarshalIndent(response, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return mcp.NewToolResultText(string(encoded)), nil
+}
+
+// toPlanResourceChange converts a parsed resourceChange into its structured-response form.
+func toPlanResourceChange(rc *resourceChange, lang diffLanguage) planResourceChange {
+	action := classifyResourceAction(rc)
+	rawAction := summarizeActions(rc.Change.Actions)
+
+	diffSummary := proposedPhrase(rawAction)
+	if action == "move" {
+		diffSummary = fmt.Sprintf("has moved to %s", rc.Address)
+	} else if lang == DetectedDrift {
+		diffSummary = driftPhrase(rawAction)
+	}
+
+	return planResourceChange{
+		Address:         rc.Address,
+		PreviousAddress: rc.PrevAddress,
+		Action:          action,
+		Reason:          formatActionReason(rc.ActionReason),
+		SensitiveBefore: markerTrue(rc.Change.BeforeSensitive),
+		SensitiveAfter:  markerTrue(rc.Change.AfterSensitive),
+		DiffSummary:     diffSummary,
+	}
+}
+
+// countActions tallies the different action types across all resource changes. Callers must
+// pass parsed.ResourceChanges only — parsed.ResourceDrift describes changes that already
+// happened outside Terraform and must never be folded into the planned add/change/destroy
+// counts.
+func countActions(changes []resourceChange) (adds, updates, destroys, replaces, moves, noops int) {
 	for _, rc := range changes {
-		switch summarizeActions(rc.Change.Actions) {
+		switch classifyResourceAction(&rc) {
 		case "create":
 			adds++
 		case "update":
@@ -272,6 +491,8 @@ func countActions(changes []resourceChange) (adds, updates, destroys, replaces,
 			destroys++
 		case "replace (delete, create)", "replace (create, delete)":
 			replaces++
+		case "move":
+			moves++
 		case "no-op", "read":
 			noops++
 		}
@@ -279,6 +500,19 @@ func countActions(changes []resourceChange) (adds, updates, destroys, replaces,
 	return
 }
 
+// classifyResourceAction classifies a resource change, recognizing moves (a rename via
+// `terraform state mv` or a `moved {}` block) that the raw actions array alone can't express.
+// A pure move has no-op actions but a non-empty previous_address; a move paired with an actual
+// change keeps its underlying action so callers can still render what's happening to it, while
+// writeResourceChange adds the move context to the header.
+func classifyResourceAction(rc *resourceChange) string {
+	action := summarizeActions(rc.Change.Actions)
+	if rc.PrevAddress != "" && action == "no-op" {
+		return "move"
+	}
+	return action
+}
+
 // summarizeActions converts the actions array into a human-readable string.
 func summarizeActions(actions []string) string {
 	if len(actions) == 1 {
@@ -319,24 +553,41 @@ func actionSymbol(action string) string {
 		return "-/+"
 	case "read":
 		return "<="
+	case "move":
+		return "~>"
 	default:
 		return " "
 	}
 }
 
-// writeResourceChange writes a single resource change entry to the string builder.
-func writeResourceChange(sb *strings.Builder, rc *resourceChange) {
-	action := summarizeActions(rc.Change.Actions)
-	symbol := actionSymbol(action)
-
-	sb.WriteString(fmt.Sprintf("### %s %s (%s)\n", symbol, rc.Address, action))
+// writeResourceChange writes a single resource change entry to the string builder. lang
+// controls whether this reads as a proposed change ("will be updated") or detected drift
+// ("has changed") — drift has already happened, so it's rendered without an action symbol.
+func writeResourceChange(sb *strings.Builder, rc *resourceChange, lang diffLanguage) {
+	action := classifyResourceAction(rc)
+	rawAction := summarizeActions(rc.Change.Actions)
+
+	switch {
+	case action == "move":
+		// A pure rename: state mv / moved {} with no other changes.
+		sb.WriteString(fmt.Sprintf("### %s %s has moved to %s\n", actionSymbol("move"), rc.PrevAddress, rc.Address))
+	case rc.PrevAddress != "" && lang == ProposedChange:
+		// Moved and changed in the same plan - lead with the move, then the change.
+		symbol := actionSymbol(rawAction)
+		sb.WriteString(fmt.Sprintf("### %s %s has moved to %s; %s\n", symbol, rc.PrevAddress, rc.Address, proposedPhrase(rawAction)))
+	case lang == DetectedDrift:
+		sb.WriteString(fmt.Sprintf("### %s %s\n", rc.Address, driftPhrase(rawAction)))
+	default:
+		symbol := actionSymbol(rawAction)
+		sb.WriteString(fmt.Sprintf("### %s %s %s\n", symbol, rc.Address, proposedPhrase(rawAction)))
+	}
 
 	if rc.ActionReason != "" {
 		sb.WriteString(fmt.Sprintf("  *Reason: %s*\n", formatActionReason(rc.ActionReason)))
 	}
 
 	// Show attribute diffs
-	diff := buildAttributeDiff(rc.Change.Before, rc.Change.After, action)
+	diff := buildAttributeDiff(rc, rawAction)
 	if diff != "" {
 		sb.WriteString("\n```diff\n")
 		sb.WriteString(diff)
@@ -346,6 +597,41 @@ func writeResourceChange(sb *strings.Builder, rc *resourceChange) {
 	}
 }
 
+// proposedPhrase renders an action as what the plan proposes to do.
+func proposedPhrase(action string) string {
+	switch action {
+	case "create":
+		return "will be created"
+	case "update":
+		return "will be updated"
+	case "delete":
+		return "will be destroyed"
+	case "replace (delete, create)", "replace (create, delete)":
+		return "will be replaced"
+	case "read":
+		return "will be read"
+	case "no-op":
+		return "has no changes"
+	default:
+		return fmt.Sprintf("(%s)", action)
+	}
+}
+
+// driftPhrase renders an action as what has already happened outside of Terraform, in the past
+// tense and with no action symbol.
+func driftPhrase(action string) string {
+	switch action {
+	case "create":
+		return "has been created outside of Terraform"
+	case "update":
+		return "has changed outside of Terraform"
+	case "delete":
+		return "has been deleted outside of Terraform"
+	default:
+		return fmt.Sprintf("has drifted (%s) outside of Terraform", action)
+	}
+}
+
 // formatActionReason converts machine-readable action reasons to human-readable text.
 func formatActionReason(reason string) string {
 	switch reason {
@@ -374,27 +660,32 @@ func formatActionReason(reason string) string {
 	}
 }
 
-// buildAttributeDiff compares before/after values and produces a compact diff.
-func buildAttributeDiff(before, after interface{}, action string) string {
+// buildAttributeDiff compares before/after values and produces a compact diff. It's
+// schema-aware in the sense that it consults the plan's after_unknown and replace_paths
+// markers (the parts of the schema-derived plan JSON that don't require a full schema fetch) so
+// computed attributes render as "(known after apply)" instead of "null -> null", and attributes
+// that force a replacement are flagged.
+func buildAttributeDiff(rc *resourceChange, action string) string {
 	var sb strings.Builder
+	replaceKeys := replacementKeys(rc.ReplacePaths)
 
 	switch action {
 	case "create":
 		// Show only the "after" values for new resources
-		if afterMap, ok := after.(map[string]interface{}); ok {
-			writeMapValues(&sb, afterMap, "+ ", 0)
+		if afterMap, ok := rc.Change.After.(map[string]interface{}); ok {
+			writeMapValues(&sb, afterMap, "+ ", 0, rc.Change.AfterUnknown, rc.Change.AfterSensitive)
 		}
 	case "delete":
 		// Show only the "before" values for deleted resources
-		if beforeMap, ok := before.(map[string]interface{}); ok {
-			writeMapValues(&sb, beforeMap, "- ", 0)
+		if beforeMap, ok := rc.Change.Before.(map[string]interface{}); ok {
+			writeMapValues(&sb, beforeMap, "- ", 0, nil, rc.Change.BeforeSensitive)
 		}
 	case "update", "replace (delete, create)", "replace (create, delete)":
 		// Show a diff of changed attributes
-		beforeMap, beforeOk := before.(map[string]interface{})
-		afterMap, afterOk := after.(map[string]interface{})
+		beforeMap, beforeOk := rc.Change.Before.(map[string]interface{})
+		afterMap, afterOk := rc.Change.After.(map[string]interface{})
 		if beforeOk && afterOk {
-			writeDiff(&sb, beforeMap, afterMap, 0)
+			writeDiff(&sb, beforeMap, afterMap, rc.Change.AfterUnknown, rc.Change.BeforeSensitive, rc.Change.AfterSensitive, replaceKeys, 0)
 		}
 	}
 
@@ -409,34 +700,110 @@ func buildAttributeDiff(before, after interface{}, action string) string {
 	return result
 }
 
-// writeMapValues writes all key/value pairs with a given prefix (for create/delete).
-func writeMapValues(sb *strings.Builder, m map[string]interface{}, prefix string, depth int) {
+// replacementKeys extracts the top-level attribute name from each entry of replace_paths, the
+// plan JSON's list of attribute paths that forced replacement on this resource.
+func replacementKeys(paths []interface{}) map[string]bool {
+	keys := make(map[string]bool)
+	for _, path := range paths {
+		if steps, ok := path.([]interface{}); ok && len(steps) > 0 {
+			if key, ok := steps[0].(string); ok {
+				keys[key] = true
+			}
+		}
+	}
+	return keys
+}
+
+// markerFor descends one level into an after_unknown-shaped marker tree for the given key.
+func markerFor(marker interface{}, key string) interface{} {
+	if m, ok := marker.(map[string]interface{}); ok {
+		return m[key]
+	}
+	return nil
+}
+
+// markerTrue reports whether a marker (from after_unknown) is the boolean `true` that indicates
+// the whole value at this path is unknown until apply.
+func markerTrue(marker interface{}) bool {
+	b, ok := marker.(bool)
+	return ok && b
+}
+
+// markerSensitive reports whether a marker (from before_sensitive/after_sensitive) is `true` at
+// this path, or a nested map/list containing `true` anywhere underneath it. Terraform marks a
+// whole container sensitive by setting `true` at a leaf or an ancestor, never partially per
+// sibling, so any `true` found in the subtree means the entire value at this path must be
+// redacted rather than rendered.
+func markerSensitive(marker interface{}) bool {
+	switch m := marker.(type) {
+	case bool:
+		return m
+	case map[string]interface{}:
+		for _, v := range m {
+			if markerSensitive(v) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, v := range m {
+			if markerSensitive(v) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// writeMapValues writes all key/value pairs with a given prefix (for create/delete). unknown is
+// the after_unknown marker tree for this map, or nil when not applicable (e.g. the before side
+// of a delete). sensitivity is the matching before_sensitive/after_sensitive marker tree, so
+// sensitive values (e.g. aws_db_instance.password) are redacted rather than printed verbatim.
+func writeMapValues(sb *strings.Builder, m map[string]interface{}, prefix string, depth int, unknown, sensitivity interface{}) {
 	indent := strings.Repeat("  ", depth)
 	for key, val := range m {
-		formatted := formatValue(val, depth+1)
+		if markerTrue(markerFor(unknown, key)) {
+			sb.WriteString(fmt.Sprintf("%s%s%s = (known after apply)\n", prefix, indent, key))
+			continue
+		}
+		formatted := formatValue(val, depth+1, markerFor(sensitivity, key))
 		sb.WriteString(fmt.Sprintf("%s%s%s = %s\n", prefix, indent, key, formatted))
 	}
 }
 
-// writeDiff compares two maps and writes only the differences.
-func writeDiff(sb *strings.Builder, before, after map[string]interface{}, depth int) {
+// writeDiff compares two maps and writes only the differences. unknown is the after_unknown
+// marker tree for the after side; beforeSensitive/afterSensitive are the matching sensitivity
+// marker trees for each side, so sensitive attributes render as "(sensitive value)" instead of
+// their real contents; and replaceKeys names the top-level attributes (from replace_paths) that
+// forced this resource's replacement, annotated with "# forces replacement".
+func writeDiff(sb *strings.Builder, before, after map[string]interface{}, unknown, beforeSensitive, afterSensitive interface{}, replaceKeys map[string]bool, depth int) {
 	indent := strings.Repeat("  ", depth)
 	allKeys := mergeKeys(before, after)
 
 	for _, key := range allKeys {
 		bVal, bExists := before[key]
 		aVal, aExists := after[key]
+		bSensitivity := markerFor(beforeSensitive, key)
+		aSensitivity := markerFor(afterSensitive, key)
+		forcesReplacement := ""
+		if depth == 0 && replaceKeys[key] {
+			forcesReplacement = " # forces replacement"
+		}
+
+		if markerTrue(markerFor(unknown, key)) {
+			sb.WriteString(fmt.Sprintf("~ %s%s = %s -> (known after apply)%s\n", indent, key, formatValue(bVal, depth+1, bSensitivity), forcesReplacement))
+			continue
+		}
 
 		if !bExists && aExists {
 			// New attribute
-			sb.WriteString(fmt.Sprintf("+ %s%s = %s\n", indent, key, formatValue(aVal, depth+1)))
+			sb.WriteString(fmt.Sprintf("+ %s%s = %s%s\n", indent, key, formatValue(aVal, depth+1, aSensitivity), forcesReplacement))
 		} else if bExists && !aExists {
 			// Removed attribute
-			sb.WriteString(fmt.Sprintf("- %s%s = %s\n", indent, key, formatValue(bVal, depth+1)))
+			sb.WriteString(fmt.Sprintf("- %s%s = %s%s\n", indent, key, formatValue(bVal, depth+1, bSensitivity), forcesReplacement))
 		} else if bExists && aExists {
 			// Attribute exists in both — check if changed
 			if !valuesEqual(bVal, aVal) {
-				sb.WriteString(fmt.Sprintf("~ %s%s = %s -> %s\n", indent, key, formatValue(bVal, depth+1), formatValue(aVal, depth+1)))
+				sb.WriteString(fmt.Sprintf("~ %s%s = %s -> %s%s\n", indent, key, formatValue(bVal, depth+1, bSensitivity), formatValue(aVal, depth+1, aSensitivity), forcesReplacement))
 			}
 		}
 	}
@@ -473,7 +840,15 @@ func sortStrings(s []string) {
 }
 
 // formatValue formats a value for display, truncating very large or deeply nested values.
-func formatValue(val interface{}, depth int) string {
+// sensitivity is the before_sensitive/after_sensitive marker tree for this value; if it (or
+// anything nested under it) is `true`, the value is redacted as "(sensitive value)" instead of
+// rendered, since markerSensitive's recursive check already covers the whole subtree, recursive
+// calls below pass nil rather than re-deriving per-element markers.
+func formatValue(val interface{}, depth int, sensitivity interface{}) string {
+	if markerSensitive(sensitivity) {
+		return "(sensitive value)"
+	}
+
 	if val == nil {
 		return "null"
 	}
@@ -500,7 +875,7 @@ func formatValue(val interface{}, depth int) string {
 		}
 		items := make([]string, 0, len(v))
 		for _, item := range v {
-			items = append(items, formatValue(item, depth+1))
+			items = append(items, formatValue(item, depth+1, nil))
 		}
 		joined := strings.Join(items, ", ")
 		if len(joined) > 200 {
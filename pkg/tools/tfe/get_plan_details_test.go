@@ -0,0 +1,139 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestMarkerSensitiveNested(t *testing.T) {
+	tests := []struct {
+		name   string
+		marker interface{}
+		want   bool
+	}{
+		{"nil marker", nil, false},
+		{"top-level true", true, true},
+		{"top-level false", false, false},
+		{"nested map with true leaf", map[string]interface{}{
+			"config": map[string]interface{}{
+				"password": true,
+				"username": false,
+			},
+		}, true},
+		{"nested map with no true anywhere", map[string]interface{}{
+			"config": map[string]interface{}{
+				"username": false,
+			},
+		}, false},
+		{"nested list with true element", []interface{}{
+			map[string]interface{}{"secret": false},
+			map[string]interface{}{"secret": true},
+		}, true},
+		{"doubly nested map with true leaf", map[string]interface{}{
+			"outer": map[string]interface{}{
+				"inner": map[string]interface{}{
+					"token": true,
+				},
+			},
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := markerSensitive(tt.marker); got != tt.want {
+				t.Errorf("markerSensitive(%#v) = %v, want %v", tt.marker, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestFormatValueRedactsNestedSensitiveMap covers the case the recursion was added for: a plan
+// attribute that is itself a map, where only one leaf deep inside it is marked sensitive. The
+// whole attribute must render as "(sensitive value)" rather than leaking the non-sensitive
+// siblings next to the masked one.
+func TestFormatValueRedactsNestedSensitiveMap(t *testing.T) {
+	const fixture = `{
+		"address": "aws_db_instance.example",
+		"mode": "managed",
+		"type": "aws_db_instance",
+		"name": "example",
+		"change": {
+			"actions": ["create"],
+			"before": null,
+			"after": {
+				"connection": {
+					"host": "db.example.com",
+					"credentials": {
+						"username": "admin",
+						"password": "hunter2"
+					}
+				}
+			},
+			"after_sensitive": {
+				"connection": {
+					"host": false,
+					"credentials": {
+						"username": false,
+						"password": true
+					}
+				}
+			}
+		}
+	}`
+
+	var rc resourceChange
+	if err := json.Unmarshal([]byte(fixture), &rc); err != nil {
+		t.Fatalf("failed to unmarshal fixture: %v", err)
+	}
+
+	afterMap, ok := rc.Change.After.(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected after to be a map, got %T", rc.Change.After)
+	}
+
+	formatted := formatValue(afterMap["connection"], 0, markerFor(rc.Change.AfterSensitive, "connection"))
+
+	if !strings.Contains(formatted, "(sensitive value)") {
+		t.Errorf("expected nested sensitive value to be redacted, got: %s", formatted)
+	}
+	if strings.Contains(formatted, "hunter2") {
+		t.Errorf("expected the masked value to not leak the sensitive password, got: %s", formatted)
+	}
+}
+
+// TestWriteMapValuesRedactsOnlyNestedSensitiveAttribute confirms that a sibling attribute
+// untouched by the sensitive marker still renders normally, so the masking is scoped to the
+// attribute the marker tree actually covers rather than the whole resource.
+func TestWriteMapValuesRedactsOnlyNestedSensitiveAttribute(t *testing.T) {
+	after := map[string]interface{}{
+		"connection": map[string]interface{}{
+			"host":     "db.example.com",
+			"password": "hunter2",
+		},
+		"name": "example-db",
+	}
+	sensitivity := map[string]interface{}{
+		"connection": map[string]interface{}{
+			"host":     false,
+			"password": true,
+		},
+	}
+
+	var sb strings.Builder
+	writeMapValues(&sb, after, "+ ", 0, nil, sensitivity)
+	out := sb.String()
+
+	if !strings.Contains(out, "(sensitive value)") {
+		t.Errorf("expected connection attribute to be redacted, got: %s", out)
+	}
+	if strings.Contains(out, "hunter2") {
+		t.Errorf("expected password to not leak, got: %s", out)
+	}
+	if !strings.Contains(out, `"example-db"`) {
+		t.Errorf("expected unrelated name attribute to render normally, got: %s", out)
+	}
+}
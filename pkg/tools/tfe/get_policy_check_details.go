@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// GetPolicyCheckDetails creates a tool to get the Sentinel/OPA policy check results and run task
+// stages for a Terraform run, closing the loop between GetApplyDetails (post-apply) and the
+// pre-apply governance surface TFC/E exposes.
+func GetPolicyCheckDetails(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_policy_check_details",
+			mcp.WithDescription(`Fetches the Sentinel/OPA policy check results and run task stages for a Terraform run, printing per-policy pass/fail/soft-fail status with a log excerpt. Use this to diagnose why a run is stuck in "policy_soft_failed" or "policy_checked" awaiting an override, before deciding whether to call override_policy_check.`),
+			mcp.WithTitleAnnotation("Get policy check results for a Terraform run"),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("run_id",
+				mcp.Required(),
+				mcp.Description("The ID of the run to get policy check details for"),
+			),
+		),
+		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getPolicyCheckDetailsHandler(ctx, req, logger)
+		},
+	}
+}
+
+func getPolicyCheckDetailsHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	runID, err := request.RequireString("run_id")
+	if err != nil {
+		return ToolError(logger, "missing required input: run_id", err)
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	run, err := tfeClient.Runs.Read(ctx, runID)
+	if err != nil {
+		return ToolErrorf(logger, "run not found: %s", runID)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Policy Check Details for Run %s\n\n", run.ID))
+	sb.WriteString(fmt.Sprintf("**Run Status:** %s\n", run.Status))
+
+	policyChecks, err := tfeClient.PolicyChecks.List(ctx, run.ID, nil)
+	if err != nil {
+		return ToolErrorf(logger, "failed to list policy checks for run %s: %v", run.ID, err)
+	}
+
+	if len(policyChecks.Items) == 0 {
+		sb.WriteString("\nNo Sentinel/OPA policy checks are configured for this run.\n")
+	} else {
+		sb.WriteString(fmt.Sprintf("\n## Policy Checks (%d)\n\n", len(policyChecks.Items)))
+		for _, pc := range policyChecks.Items {
+			writePolicyCheck(ctx, &sb, tfeClient, pc, logger)
+		}
+	}
+
+	// Task stages surface run tasks (pre-plan/post-plan/pre-apply), a separate gating mechanism
+	// from Sentinel/OPA policy checks. Not every org uses run tasks, so a failure here is logged
+	// and skipped rather than failing the whole response.
+	taskStages, err := tfeClient.TaskStages.List(ctx, run.ID, nil)
+	if err != nil {
+		logger.WithError(err).Warn("Could not list task stages for run")
+	} else if len(taskStages.Items) > 0 {
+		sb.WriteString(fmt.Sprintf("\n## Run Task Stages (%d)\n\n", len(taskStages.Items)))
+		for _, stage := range taskStages.Items {
+			sb.WriteString(fmt.Sprintf("- **%s**: %s\n", stage.Stage, stage.Status))
+		}
+	}
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+// writePolicyCheck writes a single policy check's status and a trailing excerpt of its log
+// output to the string builder.
+func writePolicyCheck(ctx context.Context, sb *strings.Builder, tfeClient *tfe.Client, pc *tfe.PolicyCheck, logger *log.Logger) {
+	sb.WriteString(fmt.Sprintf("### %s — %s\n", pc.ID, pc.Status))
+
+	if pc.Actions != nil && pc.Actions.IsOverridable {
+		sb.WriteString("*This check is soft-failed and overridable via `override_policy_check`.*\n")
+	}
+
+	logReader, err := tfeClient.PolicyChecks.Logs(ctx, pc.ID)
+	if err != nil {
+		logger.WithError(err).Warn("Could not fetch policy check logs")
+		return
+	}
+
+	logBytes, err := io.ReadAll(logReader)
+	if err != nil {
+		logger.WithError(err).Warn("Could not read policy check logs")
+		return
+	}
+
+	logContent := string(logBytes)
+	if logContent == "" {
+		return
+	}
+
+	// Keep the tail, policy failures are reported at the end of the log.
+	const maxLogSize = 4000
+	truncated := false
+	if len(logContent) > maxLogSize {
+		logContent = logContent[len(logContent)-maxLogSize:]
+		truncated = true
+	}
+
+	sb.WriteString("\n```\n")
+	if truncated {
+		sb.WriteString("... (log truncated, showing last portion)\n")
+	}
+	sb.WriteString(logContent)
+	if !strings.HasSuffix(logContent, "\n") {
+		sb.WriteString("\n")
+	}
+	sb.WriteString("```\n\n")
+}
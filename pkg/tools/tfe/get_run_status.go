@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// GetRunStatus creates a tool to check the current status of a Terraform run.
+func GetRunStatus(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("get_run_status",
+			mcp.WithDescription(`Fetches the current status of a Terraform run, along with whether it has changes, is confirmable, and its plan-queueable, applied, errored, discarded, and canceled timestamps where recorded. Use this to check on a run queued with create_run without waiting for it.`),
+			mcp.WithTitleAnnotation("Get the current status of a Terraform run"),
+			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("run_id",
+				mcp.Required(),
+				mcp.Description("The ID of the run to check"),
+			),
+		),
+		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return getRunStatusHandler(ctx, req, logger)
+		},
+	}
+}
+
+func getRunStatusHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	runID, err := request.RequireString("run_id")
+	if err != nil {
+		return ToolError(logger, "missing required input: run_id", err)
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	run, err := tfeClient.Runs.Read(ctx, runID)
+	if err != nil {
+		return ToolErrorf(logger, "run not found: %s", runID)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Run Status for %s\n\n", run.ID))
+	sb.WriteString(fmt.Sprintf("**Status:** %s\n", run.Status))
+	sb.WriteString(fmt.Sprintf("**Has Changes:** %t\n", run.HasChanges))
+	sb.WriteString(fmt.Sprintf("**Is Destroy:** %t\n", run.IsDestroy))
+	sb.WriteString(fmt.Sprintf("**Actions:** confirmable=%t, cancelable=%t, discardable=%t\n", run.Actions.IsConfirmable, run.Actions.IsCancelable, run.Actions.IsDiscardable))
+
+	if run.Message != "" {
+		sb.WriteString(fmt.Sprintf("**Message:** %s\n", run.Message))
+	}
+	if run.Plan != nil {
+		sb.WriteString(fmt.Sprintf("**Plan ID:** %s\n", run.Plan.ID))
+	}
+	if run.Apply != nil {
+		sb.WriteString(fmt.Sprintf("**Apply ID:** %s\n", run.Apply.ID))
+	}
+
+	if run.StatusTimestamps != nil {
+		sb.WriteString("\n## Timestamps\n\n")
+		if !run.StatusTimestamps.PlanQueueableAt.IsZero() {
+			sb.WriteString(fmt.Sprintf("- **Plan Queueable:** %s\n", run.StatusTimestamps.PlanQueueableAt.Format("2006-01-02 15:04:05 UTC")))
+		}
+		if !run.StatusTimestamps.AppliedAt.IsZero() {
+			sb.WriteString(fmt.Sprintf("- **Applied:** %s\n", run.StatusTimestamps.AppliedAt.Format("2006-01-02 15:04:05 UTC")))
+		}
+		if !run.StatusTimestamps.ErroredAt.IsZero() {
+			sb.WriteString(fmt.Sprintf("- **Errored:** %s\n", run.StatusTimestamps.ErroredAt.Format("2006-01-02 15:04:05 UTC")))
+		}
+		if !run.StatusTimestamps.DiscardedAt.IsZero() {
+			sb.WriteString(fmt.Sprintf("- **Discarded:** %s\n", run.StatusTimestamps.DiscardedAt.Format("2006-01-02 15:04:05 UTC")))
+		}
+		if !run.StatusTimestamps.CanceledAt.IsZero() {
+			sb.WriteString(fmt.Sprintf("- **Canceled:** %s\n", run.StatusTimestamps.CanceledAt.Format("2006-01-02 15:04:05 UTC")))
+		}
+	}
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
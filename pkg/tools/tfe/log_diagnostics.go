@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ansiEscapePattern matches ANSI color/cursor escape sequences, which Terraform's CLI output
+// includes by default and which would otherwise show up as visual noise in log content.
+var ansiEscapePattern = regexp.MustCompile("\x1b\\[[0-9;]*[a-zA-Z]")
+
+// stripANSI removes ANSI escape sequences from a string.
+func stripANSI(s string) string {
+	return ansiEscapePattern.ReplaceAllString(s, "")
+}
+
+// logDiagnostic is a single Terraform diagnostic (error or warning) extracted from a plan/apply
+// log, in the structured shape agents can act on without re-parsing the raw log text.
+type logDiagnostic struct {
+	Severity string `json:"severity"`
+	Summary  string `json:"summary"`
+	Address  string `json:"address,omitempty"`
+	Snippet  string `json:"snippet,omitempty"`
+}
+
+// diagnosticAddressPattern matches the "on <file> line <n>, in <block>:" location line Terraform
+// emits under a diagnostic header, capturing the block reference (e.g. `resource "aws_instance"
+// "foo"`) as a best-effort resource/data/module address.
+var diagnosticAddressPattern = regexp.MustCompile(`^on .+ line \d+, in (.+):$`)
+
+// extractDiagnostics scans ANSI-stripped log content for Terraform diagnostic blocks - either
+// the boxed `╷`/`│`/`╵` format used by modern Terraform CLI versions, or a bare `Error:`/
+// `Warning:` header followed by indented context - and returns them as structured diagnostics.
+func extractDiagnostics(logContent string) []logDiagnostic {
+	var diagnostics []logDiagnostic
+	lines := strings.Split(logContent, "\n")
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+
+		switch {
+		case strings.HasPrefix(strings.TrimSpace(line), "╷"):
+			var block []string
+			i++
+			for i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), "╵") {
+				block = append(block, stripBlockMarker(lines[i]))
+				i++
+			}
+			if diag, ok := parseDiagnosticBlock(block); ok {
+				diagnostics = append(diagnostics, diag)
+			}
+
+		case strings.HasPrefix(strings.TrimSpace(line), "Error:"), strings.HasPrefix(strings.TrimSpace(line), "Warning:"):
+			var block []string
+			block = append(block, strings.TrimSpace(line))
+			for i+1 < len(lines) && isIndentedOrBlank(lines[i+1]) {
+				i++
+				block = append(block, strings.TrimSpace(lines[i]))
+			}
+			if diag, ok := parseDiagnosticBlock(block); ok {
+				diagnostics = append(diagnostics, diag)
+			}
+		}
+	}
+
+	return diagnostics
+}
+
+// stripBlockMarker removes the leading "│ " (or bare "│") column marker from a line inside a
+// ╷/╵ diagnostic block.
+func stripBlockMarker(line string) string {
+	trimmed := strings.TrimSpace(line)
+	trimmed = strings.TrimPrefix(trimmed, "│")
+	return strings.TrimSpace(trimmed)
+}
+
+// isIndentedOrBlank reports whether a line is part of a bare Error:/Warning: block's continuation
+// (indented context) rather than the start of an unrelated line.
+func isIndentedOrBlank(line string) bool {
+	return strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") || strings.TrimSpace(line) == ""
+}
+
+// parseDiagnosticBlock interprets a diagnostic block's lines (already stripped of box markers)
+// as a severity, summary, optional address, and source snippet.
+func parseDiagnosticBlock(block []string) (logDiagnostic, bool) {
+	if len(block) == 0 {
+		return logDiagnostic{}, false
+	}
+
+	header := block[0]
+	var diag logDiagnostic
+	switch {
+	case strings.HasPrefix(header, "Error:"):
+		diag.Severity = "error"
+		diag.Summary = strings.TrimSpace(strings.TrimPrefix(header, "Error:"))
+	case strings.HasPrefix(header, "Warning:"):
+		diag.Severity = "warning"
+		diag.Summary = strings.TrimSpace(strings.TrimPrefix(header, "Warning:"))
+	default:
+		return logDiagnostic{}, false
+	}
+
+	var snippetLines []string
+	for _, line := range block[1:] {
+		if match := diagnosticAddressPattern.FindStringSubmatch(line); match != nil {
+			diag.Address = match[1]
+			continue
+		}
+		if line == "" {
+			continue
+		}
+		snippetLines = append(snippetLines, line)
+	}
+	diag.Snippet = strings.Join(snippetLines, "\n")
+
+	return diag, true
+}
+
+// formatDiagnosticsSection renders extracted diagnostics as a Markdown section.
+func formatDiagnosticsSection(diagnostics []logDiagnostic) string {
+	if len(diagnostics) == 0 {
+		return "\n## Diagnostics\n\nNo errors or warnings found in the log.\n"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("\n## Diagnostics (%d)\n\n", len(diagnostics)))
+	for i, diag := range diagnostics {
+		sb.WriteString(fmt.Sprintf("### %d. [%s] %s\n\n", i+1, strings.ToUpper(diag.Severity), diag.Summary))
+		if diag.Address != "" {
+			sb.WriteString(fmt.Sprintf("**Location:** %s\n\n", diag.Address))
+		}
+		if diag.Snippet != "" {
+			sb.WriteString("```\n")
+			sb.WriteString(diag.Snippet)
+			sb.WriteString("\n```\n\n")
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// logStreamOptions bundles the tail_lines/since_offset/follow parameters shared by the apply-
+// and plan-log tools, so large logs can be tailed or resumed incrementally instead of buffered
+// whole and truncated.
+type logStreamOptions struct {
+	TailLines   int
+	SinceOffset int64
+	Follow      bool
+}
+
+// withLogStreamParams adds the tail_lines/since_offset/follow tool parameters to an
+// mcp.NewTool(...) option list.
+func withLogStreamParams() []mcp.ToolOption {
+	return []mcp.ToolOption{
+		mcp.WithString("tail_lines",
+			mcp.Description("If set, return only the last N lines of the log, bounded by a ring buffer instead of a flat byte truncation"),
+		),
+		mcp.WithString("since_offset",
+			mcp.Description("Byte offset into the log to resume from, as returned by a previous call - lets a follow=true poll loop fetch only newly appended content"),
+		),
+		mcp.WithString("follow",
+			mcp.Description(`Whether to poll until the run reaches a terminal status, emitting incremental progress notifications as new log content arrives: 'true' or 'false' (default: 'false')`),
+		),
+	}
+}
+
+// parseLogStreamOptions reads and validates the tail_lines/since_offset/follow parameters.
+func parseLogStreamOptions(request mcp.CallToolRequest) (logStreamOptions, error) {
+	var opts logStreamOptions
+
+	if v := strings.TrimSpace(request.GetString("tail_lines", "")); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return opts, fmt.Errorf("invalid tail_lines '%s' - must be a non-negative integer", v)
+		}
+		opts.TailLines = n
+	}
+
+	if v := strings.TrimSpace(request.GetString("since_offset", "")); v != "" {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || n < 0 {
+			return opts, fmt.Errorf("invalid since_offset '%s' - must be a non-negative integer", v)
+		}
+		opts.SinceOffset = n
+	}
+
+	opts.Follow = strings.ToLower(strings.TrimSpace(request.GetString("follow", "false"))) == "true"
+
+	return opts, nil
+}
+
+// logRingBuffer retains only the most recently pushed N lines, bounding memory when tailing a
+// log that may be arbitrarily large instead of holding the whole thing in a strings.Builder.
+type logRingBuffer struct {
+	lines []string
+	head  int
+	count int
+}
+
+func newLogRingBuffer(capacity int) *logRingBuffer {
+	return &logRingBuffer{lines: make([]string, capacity)}
+}
+
+func (r *logRingBuffer) push(line string) {
+	if len(r.lines) == 0 {
+		return
+	}
+	r.lines[r.head] = line
+	r.head = (r.head + 1) % len(r.lines)
+	if r.count < len(r.lines) {
+		r.count++
+	}
+}
+
+// ordered returns the buffered lines oldest-first.
+func (r *logRingBuffer) ordered() []string {
+	if r.count < len(r.lines) {
+		return append([]string(nil), r.lines[:r.count]...)
+	}
+	ordered := make([]string, 0, len(r.lines))
+	ordered = append(ordered, r.lines[r.head:]...)
+	ordered = append(ordered, r.lines[:r.head]...)
+	return ordered
+}
+
+// progressNotificationInterval is how many bytes of log content are streamed between progress
+// notifications, so a multi-megabyte apply log doesn't flood the client with one notification
+// per line.
+const progressNotificationInterval = 8192
+
+// streamLogLines scans a log reader line-by-line, applying since_offset/tail_lines and sending
+// MCP progress notifications as content streams in. It returns the rendered log content (subject
+// to tail_lines or, when unset, the legacy 50 KB tail truncation) and the total byte offset
+// reached, which callers can hand back as since_offset on a follow-up call.
+func streamLogLines(ctx context.Context, request mcp.CallToolRequest, logReader io.Reader, opts logStreamOptions, logger *log.Logger) (string, int64, error) {
+	const legacyMaxLogSize = 50000
+
+	var ring *logRingBuffer
+	if opts.TailLines > 0 {
+		ring = newLogRingBuffer(opts.TailLines)
+	}
+
+	var flat strings.Builder
+	var offset int64
+	var sinceLastNotification int64
+
+	scanner := bufio.NewScanner(logReader)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		lineBytes := int64(len(line)) + 1
+		startOffset := offset
+		offset += lineBytes
+
+		if startOffset < opts.SinceOffset {
+			continue
+		}
+
+		if ring != nil {
+			ring.push(line)
+		} else {
+			flat.WriteString(line)
+			flat.WriteString("\n")
+		}
+
+		sinceLastNotification += lineBytes
+		if sinceLastNotification >= progressNotificationInterval {
+			sendLogProgressNotification(ctx, request, float64(offset))
+			sinceLastNotification = 0
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", offset, err
+	}
+
+	sendLogProgressNotification(ctx, request, float64(offset))
+
+	if ring != nil {
+		return strings.Join(ring.ordered(), "\n") + "\n", offset, nil
+	}
+
+	content := flat.String()
+	if len(content) > legacyMaxLogSize {
+		content = "... (log truncated, showing last portion which typically contains errors)\n" + content[len(content)-legacyMaxLogSize:]
+	}
+	return content, offset, nil
+}
+
+// sendLogProgressNotification emits an MCP progress notification for the calling tool's progress
+// token, if the client supplied one. Clients that didn't request progress tracking (no token)
+// are silently skipped, matching the optional nature of MCP progress reporting.
+func sendLogProgressNotification(ctx context.Context, request mcp.CallToolRequest, bytesStreamed float64) {
+	if request.Params.Meta == nil || request.Params.Meta.ProgressToken == nil {
+		return
+	}
+	token := request.Params.Meta.ProgressToken
+
+	srv := server.ServerFromContext(ctx)
+	if srv == nil {
+		return
+	}
+
+	notification := mcp.NewProgressNotification(token, bytesStreamed, nil)
+	if err := srv.SendNotificationToClient(ctx, notification.Method, notification.Params); err != nil {
+		log.StandardLogger().WithError(err).Debug("could not send log streaming progress notification")
+	}
+}
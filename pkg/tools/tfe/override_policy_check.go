@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// OverridePolicyCheck creates a tool to override a soft-failed Sentinel/OPA policy check,
+// unblocking a run that's stuck in policy_soft_failed. This bypasses a governance guardrail, so
+// the handler refuses to act unless client.WriteEnabled() reports the server was started with
+// write access enabled (TFE_MCP_ENABLE_WRITE=true) - the tool is still registered either way,
+// since registration here doesn't have access to server-level startup flags, but it will not
+// override anything until that opt-in is set.
+func OverridePolicyCheck(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("override_policy_check",
+			mcp.WithDescription(`Overrides a soft-failed Sentinel/OPA policy check, allowing its run to proceed despite the advisory failure. Only soft-failed checks can be overridden - a hard-failed check must be fixed in configuration instead. Use get_policy_check_details first to find the policy_check_id and confirm it's overridable. Requires the server to be started with write access enabled (TFE_MCP_ENABLE_WRITE=true); otherwise this tool refuses the request.`),
+			mcp.WithTitleAnnotation("Override a soft-failed policy check"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(true),
+			mcp.WithString("policy_check_id",
+				mcp.Required(),
+				mcp.Description("The ID of the policy check to override"),
+			),
+		),
+		Handler: func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return overridePolicyCheckHandler(ctx, req, logger)
+		},
+	}
+}
+
+func overridePolicyCheckHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	if !client.WriteEnabled() {
+		return ToolError(logger, "override_policy_check is disabled: set TFE_MCP_ENABLE_WRITE=true to allow overriding policy checks", nil)
+	}
+
+	policyCheckID, err := request.RequireString("policy_check_id")
+	if err != nil {
+		return ToolError(logger, "missing required input: policy_check_id", err)
+	}
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	pc, err := tfeClient.PolicyChecks.Read(ctx, policyCheckID)
+	if err != nil {
+		return ToolErrorf(logger, "policy check not found: %s", policyCheckID)
+	}
+
+	if pc.Actions == nil || !pc.Actions.IsOverridable {
+		return ToolErrorf(logger, "policy check %s is not overridable in its current status '%s'", policyCheckID, pc.Status)
+	}
+
+	overridden, err := tfeClient.PolicyChecks.Override(ctx, policyCheckID)
+	if err != nil {
+		return ToolErrorf(logger, "failed to override policy check %s: %v", policyCheckID, err)
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Policy check %s has been overridden (status: %s).", overridden.ID, overridden.Status)), nil
+}
@@ -0,0 +1,259 @@
+// Copyright IBM Corp. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-mcp-server/pkg/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+)
+
+// UpdateWorkspace creates a tool to update an existing Terraform workspace's settings and tags.
+func UpdateWorkspace(logger *log.Logger) server.ServerTool {
+	return server.ServerTool{
+		Tool: mcp.NewTool("update_workspace",
+			mcp.WithDescription(`Updates an existing Terraform workspace's settings: description, Terraform version, working directory, auto-apply, execution mode, VCS repo, and run triggers. Unlike create_workspace, it does not change the workspace's project, team access, or variable sets, and has no per-call tfe_hostname/tfe_token overrides. It also supports idempotent tag reconciliation: when the requested tags differ from the workspace's current tags, only the difference is added/removed rather than replacing the whole tag set.`),
+			mcp.WithTitleAnnotation("Update a Terraform workspace"),
+			mcp.WithOpenWorldHintAnnotation(true),
+			mcp.WithReadOnlyHintAnnotation(false),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("terraform_org_name",
+				mcp.Required(),
+				mcp.Description("The Terraform Cloud/Enterprise organization name"),
+			),
+			mcp.WithString("workspace_name",
+				mcp.Required(),
+				mcp.Description("The name of the workspace to update"),
+			),
+			mcp.WithString("description",
+				mcp.Description("Optional new description for the workspace"),
+			),
+			mcp.WithString("terraform_version",
+				mcp.Description("Optional new Terraform version to use (e.g., '1.5.0')"),
+			),
+			mcp.WithString("working_directory",
+				mcp.Description("Optional new working directory for Terraform operations"),
+			),
+			mcp.WithString("auto_apply",
+				mcp.Description("Whether to automatically apply successful plans: 'true' or 'false'"),
+			),
+			mcp.WithString("execution_mode",
+				mcp.Description("Execution mode: 'remote', 'local', or 'agent'"),
+			),
+			mcp.WithString("vcs_repo_identifier",
+				mcp.Description("Optional VCS repository identifier in human-readable 'owner/repo' format"),
+			),
+			mcp.WithString("vcs_repo_branch",
+				mcp.Description("Optional VCS repository branch"),
+			),
+			mcp.WithString("vcs_repo_oauth_token_id",
+				mcp.Description("OAuth token ID for VCS integration, required when vcs_repo_identifier is set"),
+			),
+			mcp.WithString("trigger_patterns",
+				mcp.Description("Optional comma-separated list of glob patterns that determine which file changes trigger a run. Mutually exclusive with trigger_prefixes."),
+			),
+			mcp.WithString("trigger_prefixes",
+				mcp.Description("Optional comma-separated list of path prefixes that determine which file changes trigger a run. Mutually exclusive with trigger_patterns."),
+			),
+			mcp.WithString("file_triggers_enabled",
+				mcp.Description("Whether runs should only be triggered by relevant file changes: 'true' or 'false'"),
+			),
+			mcp.WithString("tags",
+				mcp.Description("Optional comma-separated list of desired tags. Combined with desired_tags_mode to decide how it's reconciled against the workspace's current tags."),
+			),
+			mcp.WithString("desired_tags_mode",
+				mcp.Description("How to reconcile 'tags' with the workspace's current tags: 'merge' (union, default) or 'replace' (force-set, removing anything not listed)"),
+			),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return updateWorkspaceHandler(ctx, request, logger)
+		},
+	}
+}
+
+func updateWorkspaceHandler(ctx context.Context, request mcp.CallToolRequest, logger *log.Logger) (*mcp.CallToolResult, error) {
+	terraformOrgName, err := request.RequireString("terraform_org_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: terraform_org_name", err)
+	}
+	terraformOrgName = strings.TrimSpace(terraformOrgName)
+
+	workspaceName, err := request.RequireString("workspace_name")
+	if err != nil {
+		return ToolError(logger, "missing required input: workspace_name", err)
+	}
+	workspaceName = strings.TrimSpace(workspaceName)
+
+	tfeClient, err := client.GetTfeClientFromContext(ctx, logger)
+	if err != nil {
+		return ToolError(logger, "failed to get Terraform client", err)
+	}
+
+	workspace, err := tfeClient.Workspaces.Read(ctx, terraformOrgName, workspaceName)
+	if err != nil {
+		return ToolErrorf(logger, "workspace '%s' not found in org '%s'", workspaceName, terraformOrgName)
+	}
+
+	options := tfe.WorkspaceUpdateOptions{}
+	hasUpdate := false
+
+	if v := request.GetString("description", ""); v != "" {
+		options.Description = &v
+		hasUpdate = true
+	}
+	if v := request.GetString("terraform_version", ""); v != "" {
+		options.TerraformVersion = &v
+		hasUpdate = true
+	}
+	if v := request.GetString("working_directory", ""); v != "" {
+		options.WorkingDirectory = &v
+		hasUpdate = true
+	}
+	if v := request.GetString("auto_apply", ""); v != "" {
+		autoApply := strings.ToLower(v) == "true"
+		options.AutoApply = &autoApply
+		hasUpdate = true
+	}
+	if v := strings.ToLower(request.GetString("execution_mode", "")); v != "" {
+		switch v {
+		case "local", "agent", "remote":
+			options.ExecutionMode = tfe.String(v)
+			hasUpdate = true
+		default:
+			return ToolErrorf(logger, "invalid execution_mode '%s' - must be 'remote', 'local', or 'agent'", v)
+		}
+	}
+
+	vcsRepoIdentifier := request.GetString("vcs_repo_identifier", "")
+	if vcsRepoIdentifier != "" {
+		vcsRepoOAuthTokenID := request.GetString("vcs_repo_oauth_token_id", "")
+		if vcsRepoOAuthTokenID == "" {
+			return ToolError(logger, "vcs_repo_oauth_token_id is required when vcs_repo_identifier is provided", nil)
+		}
+		vcsRepo := &tfe.VCSRepoOptions{
+			Identifier:   &vcsRepoIdentifier,
+			OAuthTokenID: &vcsRepoOAuthTokenID,
+		}
+		if v := request.GetString("vcs_repo_branch", ""); v != "" {
+			vcsRepo.Branch = &v
+		}
+		options.VCSRepo = vcsRepo
+		hasUpdate = true
+	}
+
+	triggerPatternsStr := request.GetString("trigger_patterns", "")
+	triggerPrefixesStr := request.GetString("trigger_prefixes", "")
+	fileTriggersEnabledStr := request.GetString("file_triggers_enabled", "")
+	if triggerPatternsStr != "" || triggerPrefixesStr != "" || fileTriggersEnabledStr != "" {
+		if triggerPatternsStr != "" && triggerPrefixesStr != "" {
+			return ToolError(logger, "trigger_patterns and trigger_prefixes are mutually exclusive - Terraform Cloud/Enterprise rejects workspaces that set both", nil)
+		}
+		if triggerPatternsStr != "" {
+			options.TriggerPatterns = splitAndTrim(triggerPatternsStr)
+			hasUpdate = true
+		}
+		if triggerPrefixesStr != "" {
+			options.TriggerPrefixes = splitAndTrim(triggerPrefixesStr)
+			hasUpdate = true
+		}
+		if fileTriggersEnabledStr != "" {
+			fileTriggersEnabled := strings.ToLower(fileTriggersEnabledStr) == "true"
+			options.FileTriggersEnabled = &fileTriggersEnabled
+			hasUpdate = true
+		}
+	}
+
+	updateCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if hasUpdate {
+		workspace, err = tfeClient.Workspaces.UpdateByID(updateCtx, workspace.ID, options)
+		if err != nil {
+			return ToolErrorf(logger, "failed to update workspace '%s': %v", workspaceName, err)
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Updated Workspace: %s/%s\n\n", terraformOrgName, workspaceName))
+	sb.WriteString(fmt.Sprintf("**Workspace ID:** %s\n", workspace.ID))
+
+	if tagsStr := request.GetString("tags", ""); tagsStr != "" {
+		desiredTagsMode := strings.ToLower(request.GetString("desired_tags_mode", "merge"))
+		if desiredTagsMode != "merge" && desiredTagsMode != "replace" {
+			return ToolErrorf(logger, "invalid desired_tags_mode '%s' - must be 'merge' or 'replace'", desiredTagsMode)
+		}
+
+		added, removed, err := reconcileWorkspaceTags(updateCtx, tfeClient, workspace, splitAndTrim(tagsStr), desiredTagsMode)
+		if err != nil {
+			return ToolErrorf(logger, "failed to reconcile tags for workspace '%s': %v", workspaceName, err)
+		}
+
+		sb.WriteString(fmt.Sprintf("\n## Tags Reconciled (mode: %s)\n\n", desiredTagsMode))
+		if len(added) > 0 {
+			sb.WriteString(fmt.Sprintf("- Added: %s\n", strings.Join(added, ", ")))
+		}
+		if len(removed) > 0 {
+			sb.WriteString(fmt.Sprintf("- Removed: %s\n", strings.Join(removed, ", ")))
+		}
+		if len(added) == 0 && len(removed) == 0 {
+			sb.WriteString("- No changes - workspace tags already match.\n")
+		}
+	}
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+// reconcileWorkspaceTags diffs the workspace's current tags against the desired tag set and
+// applies only the add/remove delta via tfeClient.Workspaces.AddTags/RemoveTags, mirroring how
+// the cloud backend's StateMgr performs a targeted AddTags when tags drift. In "replace" mode,
+// any current tag not in the desired set is removed; in "merge" mode, current tags are kept.
+func reconcileWorkspaceTags(ctx context.Context, tfeClient *tfe.Client, workspace *tfe.Workspace, desired []string, mode string) (added, removed []string, err error) {
+	current := make(map[string]bool, len(workspace.TagNames))
+	for _, t := range workspace.TagNames {
+		current[t] = true
+	}
+
+	wanted := make(map[string]bool, len(desired))
+	for _, t := range desired {
+		wanted[t] = true
+	}
+
+	var toAdd []*tfe.Tag
+	for t := range wanted {
+		if !current[t] {
+			toAdd = append(toAdd, &tfe.Tag{Name: t})
+			added = append(added, t)
+		}
+	}
+
+	var toRemove []*tfe.Tag
+	if mode == "replace" {
+		for t := range current {
+			if !wanted[t] {
+				toRemove = append(toRemove, &tfe.Tag{Name: t})
+				removed = append(removed, t)
+			}
+		}
+	}
+
+	if len(toAdd) > 0 {
+		if err := tfeClient.Workspaces.AddTags(ctx, workspace.ID, tfe.WorkspaceAddTagsOptions{Tags: toAdd}); err != nil {
+			return nil, nil, fmt.Errorf("failed to add tags: %w", err)
+		}
+	}
+	if len(toRemove) > 0 {
+		if err := tfeClient.Workspaces.RemoveTags(ctx, workspace.ID, tfe.WorkspaceRemoveTagsOptions{Tags: toRemove}); err != nil {
+			return nil, nil, fmt.Errorf("failed to remove tags: %w", err)
+		}
+	}
+
+	return added, removed, nil
+}